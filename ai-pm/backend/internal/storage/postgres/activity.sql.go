@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/activity.sql
+type activityRepo struct {
+	db dbtx
+}
+
+func scanActivityEvent(row interface{ Scan(...interface{}) error }) (storage.ActivityEvent, error) {
+	var e storage.ActivityEvent
+	err := row.Scan(&e.ID, &e.Actor, &e.ProjectID, &e.ObjectType, &e.ObjectID, &e.Action, &e.Description, &e.Diff, &e.CreatedAt)
+	return e, err
+}
+
+// name: RecordActivity :one
+func (r *activityRepo) Record(ctx context.Context, e storage.ActivityEvent) (storage.ActivityEvent, error) {
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO activity_events (actor, project_id, object_type, object_id, action, description, diff)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, actor, project_id, object_type, object_id, action, description, diff, created_at`,
+		e.Actor, e.ProjectID, e.ObjectType, e.ObjectID, e.Action, e.Description, e.Diff)
+	return scanActivityEvent(row)
+}
+
+// name: ListActivityForProject :many / CountActivityForProject :one
+func (r *activityRepo) ListForProject(ctx context.Context, projectID int, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error) {
+	return r.list(ctx, "project_id = $1", projectID, limit, before, beforeID)
+}
+
+// name: ListActivityForTask :many / CountActivityForTask :one
+func (r *activityRepo) ListForTask(ctx context.Context, taskID int, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error) {
+	return r.list(ctx, "object_type = 'task' AND object_id = $1", taskID, limit, before, beforeID)
+}
+
+// list is shared by ListForProject/ListForTask, which differ only in their
+// WHERE filter; both page newest-first on (created_at, id), the same cursor
+// shape GetTasks/GetProjects/GetNotes use (see listCursor in main.go).
+func (r *activityRepo) list(ctx context.Context, filter string, filterArg interface{}, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM activity_events WHERE "+filter, filterArg).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT id, actor, project_id, object_type, object_id, action, description, diff, created_at
+		FROM activity_events WHERE ` + filter
+	args := []interface{}{filterArg}
+
+	if before != nil {
+		query += " AND (created_at, id) < ($2, $3)"
+		args = append(args, *before, beforeID)
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT $" + strconv.Itoa(len(args)+1)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	events := []storage.ActivityEvent{}
+	for rows.Next() {
+		e, err := scanActivityEvent(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, e)
+	}
+	return events, total, rows.Err()
+}
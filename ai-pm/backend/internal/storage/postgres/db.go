@@ -0,0 +1,106 @@
+// Package postgres is the Postgres-backed implementation of the
+// internal/storage interfaces. Fixed-shape queries live in the *.sql.go
+// files alongside the queries/*.sql source sqlc generates them from; the
+// handful of dynamic-field update methods are hand-written, since sqlc only
+// fits queries whose shape is known at codegen time.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// dbtx is satisfied by both *sql.DB and *sql.Tx, so every repo method works
+// unchanged whether it's running against the plain connection or inside a
+// Store.Tx callback.
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Store is the Postgres-backed storage.Store.
+type Store struct {
+	repos storage.Repos
+	db    *sql.DB
+}
+
+// NewStore wraps db as a storage.Store, ready for use by ai-pm's handlers.
+func NewStore(db *sql.DB) *Store {
+	return &Store{repos: reposFor(db), db: db}
+}
+
+func (s *Store) Projects() storage.ProjectRepo        { return s.repos.Projects }
+func (s *Store) Tasks() storage.TaskRepo              { return s.repos.Tasks }
+func (s *Store) Notes() storage.NoteRepo              { return s.repos.Notes }
+func (s *Store) Labels() storage.LabelRepo            { return s.repos.Labels }
+func (s *Store) Dependencies() storage.DependencyRepo { return s.repos.Dependencies }
+func (s *Store) Activity() storage.ActivityRepo       { return s.repos.Activity }
+
+// reposFor builds a Repos bag backed by conn, which may be the top-level
+// *sql.DB or a *sql.Tx from Tx below.
+func reposFor(conn dbtx) storage.Repos {
+	return storage.Repos{
+		Projects:     &projectRepo{db: conn},
+		Tasks:        &taskRepo{db: conn},
+		Notes:        &noteRepo{db: conn},
+		Labels:       &labelRepo{db: conn},
+		Dependencies: &dependencyRepo{db: conn},
+		Activity:     &activityRepo{db: conn},
+	}
+}
+
+// Tx runs fn within a single transaction, passing it a Repos bound to that
+// transaction. The transaction commits if fn returns nil and rolls back
+// otherwise.
+func (s *Store) Tx(ctx context.Context, fn func(storage.Repos) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(reposFor(tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BulkTx implements storage.Store.BulkTx using a real SAVEPOINT per i, so
+// one i's SQL error rolls back only that i's statements instead of
+// poisoning the rest of the transaction.
+func (s *Store) BulkTx(ctx context.Context, n int, fn func(storage.Repos, int) error) ([]error, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	repos := reposFor(tx)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		sp := fmt.Sprintf("bulk_sp_%d", i)
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+sp); err != nil {
+			return nil, err
+		}
+		if err := fn(repos, i); err != nil {
+			errs[i] = err
+			if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+sp); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+sp); err != nil {
+			return nil, err
+		}
+	}
+	return errs, tx.Commit()
+}
+
+// Close closes the underlying database connection.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
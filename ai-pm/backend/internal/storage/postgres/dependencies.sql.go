@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/dependencies.sql
+type dependencyRepo struct {
+	db dbtx
+}
+
+// name: CreateTaskDependency :one
+func (r *dependencyRepo) Create(ctx context.Context, taskID, dependsOnTaskID int) (storage.TaskDependency, error) {
+	var d storage.TaskDependency
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO task_dependencies (task_id, depends_on_task_id)
+		VALUES ($1, $2)
+		RETURNING id, task_id, depends_on_task_id, created_at`,
+		taskID, dependsOnTaskID)
+	err := row.Scan(&d.ID, &d.TaskID, &d.DependsOnTaskID, &d.CreatedAt)
+	return d, err
+}
+
+// name: DeleteTaskDependency :exec
+func (r *dependencyRepo) Delete(ctx context.Context, taskID, depID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM task_dependencies WHERE id = $1 AND task_id = $2", depID, taskID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// name: UpstreamDependencies :many
+func (r *dependencyRepo) Upstream(ctx context.Context, taskID int) ([]storage.Task, error) {
+	return r.relatedTasks(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		JOIN task_dependencies td ON td.depends_on_task_id = t.id
+		WHERE td.task_id = $1 AND t.deleted_at IS NULL`, taskColumns), taskID)
+}
+
+// name: DownstreamDependencies :many
+func (r *dependencyRepo) Downstream(ctx context.Context, taskID int) ([]storage.Task, error) {
+	return r.relatedTasks(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		JOIN task_dependencies td ON td.task_id = t.id
+		WHERE td.depends_on_task_id = $1 AND t.deleted_at IS NULL`, taskColumns), taskID)
+}
+
+func (r *dependencyRepo) relatedTasks(ctx context.Context, query string, taskID int) ([]storage.Task, error) {
+	rows, err := r.db.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []storage.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+// name: TaskReachesTask :one
+//
+// Reaches walks depends_on_task_id edges transitively from fromTaskID via a
+// recursive CTE; it's used to reject a dependency insert that would close a
+// cycle (see storage.DependencyRepo.Reaches).
+func (r *dependencyRepo) Reaches(ctx context.Context, fromTaskID, toTaskID int) (bool, error) {
+	var reaches bool
+	err := r.db.QueryRowContext(ctx, `
+		WITH RECURSIVE reachable AS (
+			SELECT depends_on_task_id AS task_id FROM task_dependencies WHERE task_id = $1
+			UNION
+			SELECT td.depends_on_task_id
+			FROM task_dependencies td
+			JOIN reachable r ON td.task_id = r.task_id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE task_id = $2)`,
+		fromTaskID, toTaskID).Scan(&reaches)
+	return reaches, err
+}
+
+// name: CountUnresolvedDependencies :one
+func (r *dependencyRepo) UnresolvedCount(ctx context.Context, taskID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM task_dependencies td
+		JOIN tasks t ON t.id = td.depends_on_task_id
+		WHERE td.task_id = $1 AND t.deleted_at IS NULL AND t.status != 'done'`,
+		taskID).Scan(&count)
+	return count, err
+}
+
+// name: ProjectGraphNodes / ProjectGraphEdges
+func (r *dependencyRepo) GraphForProject(ctx context.Context, projectID int) ([]storage.GraphNode, []storage.GraphEdge, error) {
+	nodeRows, err := r.db.QueryContext(ctx,
+		"SELECT id, title, status FROM tasks WHERE project_id = $1 AND deleted_at IS NULL", projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer nodeRows.Close()
+
+	nodes := []storage.GraphNode{}
+	for nodeRows.Next() {
+		var n storage.GraphNode
+		if err := nodeRows.Scan(&n.TaskID, &n.Title, &n.Status); err != nil {
+			return nil, nil, err
+		}
+		nodes = append(nodes, n)
+	}
+	if err := nodeRows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	edgeRows, err := r.db.QueryContext(ctx, `
+		SELECT td.task_id, td.depends_on_task_id
+		FROM task_dependencies td
+		JOIN tasks t ON t.id = td.task_id
+		WHERE t.project_id = $1 AND t.deleted_at IS NULL`, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer edgeRows.Close()
+
+	edges := []storage.GraphEdge{}
+	for edgeRows.Next() {
+		var e storage.GraphEdge
+		if err := edgeRows.Scan(&e.TaskID, &e.DependsOnTaskID); err != nil {
+			return nil, nil, err
+		}
+		edges = append(edges, e)
+	}
+	return nodes, edges, edgeRows.Err()
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/labels.sql
+type labelRepo struct {
+	db dbtx
+}
+
+func scanLabel(row interface{ Scan(...interface{}) error }) (storage.Label, error) {
+	var l storage.Label
+	err := row.Scan(&l.ID, &l.Key, &l.Color, &l.CreatedAt)
+	return l, err
+}
+
+// name: ListLabels :many
+func (r *labelRepo) List(ctx context.Context) ([]storage.Label, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, key, color, created_at FROM labels ORDER BY key")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []storage.Label{}
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, rows.Err()
+}
+
+// name: CreateLabel :one
+func (r *labelRepo) Create(ctx context.Context, key, color string) (storage.Label, error) {
+	row := r.db.QueryRowContext(ctx,
+		"INSERT INTO labels (key, color) VALUES ($1, $2) RETURNING id, key, color, created_at", key, color)
+	l, err := scanLabel(row)
+	if err != nil && strings.Contains(err.Error(), "duplicate key") {
+		return l, storage.ErrConflict
+	}
+	return l, err
+}
+
+// name: GetLabel :one
+func (r *labelRepo) Get(ctx context.Context, id int) (storage.Label, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT id, key, color, created_at FROM labels WHERE id = $1", id)
+	l, err := scanLabel(row)
+	if err == sql.ErrNoRows {
+		return l, storage.ErrNotFound
+	}
+	return l, err
+}
+
+// name: ResolveOrCreateLabelID :one
+func (r *labelRepo) ResolveOrCreateID(ctx context.Context, key string) (int, error) {
+	var id int
+	err := r.db.QueryRowContext(ctx,
+		"INSERT INTO labels (key) VALUES ($1) ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key RETURNING id",
+		key).Scan(&id)
+	return id, err
+}
+
+// name: ListLabelsForTask :many
+func (r *labelRepo) ForTask(ctx context.Context, taskID int) ([]storage.Label, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.key, l.color, l.created_at
+		FROM labels l
+		JOIN task_labels tl ON tl.label_id = l.id
+		WHERE tl.task_id = $1
+		ORDER BY l.key`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []storage.Label{}
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, rows.Err()
+}
+
+// name: ListLabelsForProject :many
+func (r *labelRepo) ForProject(ctx context.Context, projectID int) ([]storage.Label, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.key, l.color, l.created_at
+		FROM labels l
+		JOIN project_labels pl ON pl.label_id = l.id
+		WHERE pl.project_id = $1
+		ORDER BY l.key`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := []storage.Label{}
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+	return labels, rows.Err()
+}
+
+// name: RemoveTaskLabelsByScope :exec
+// Matches labels whose key sits exactly one level below scope (e.g. scope
+// "a/b" matches "a/b/c" but not "a/b/c/d", which belongs to the deeper
+// scope "a/b/c"). A plain `key LIKE scope || '/%'` prefix match would also
+// evict that deeper scope's labels.
+func (r *labelRepo) RemoveTaskLabelsByScope(ctx context.Context, taskID int, scope string) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM task_labels
+		USING labels
+		WHERE task_labels.label_id = labels.id
+		  AND task_labels.task_id = $1
+		  AND substring(labels.key from '^(.*)/[^/]*$') = $2`,
+		taskID, scope)
+	return err
+}
+
+// name: RemoveProjectLabelsByScope :exec
+// See RemoveTaskLabelsByScope for why this matches the exact scope rather
+// than a '/%' prefix.
+func (r *labelRepo) RemoveProjectLabelsByScope(ctx context.Context, projectID int, scope string) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM project_labels
+		USING labels
+		WHERE project_labels.label_id = labels.id
+		  AND project_labels.project_id = $1
+		  AND substring(labels.key from '^(.*)/[^/]*$') = $2`,
+		projectID, scope)
+	return err
+}
+
+// name: AddTaskLabel :exec
+func (r *labelRepo) AddTaskLabel(ctx context.Context, taskID, labelID int) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO task_labels (task_id, label_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", taskID, labelID)
+	return err
+}
+
+// name: AddProjectLabel :exec
+func (r *labelRepo) AddProjectLabel(ctx context.Context, projectID, labelID int) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO project_labels (project_id, label_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", projectID, labelID)
+	return err
+}
+
+// name: RemoveTaskLabel :exec
+func (r *labelRepo) RemoveTaskLabel(ctx context.Context, taskID, labelID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM task_labels WHERE task_id = $1 AND label_id = $2", taskID, labelID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	return rowsAffected > 0, err
+}
+
+// name: RemoveProjectLabel :exec
+func (r *labelRepo) RemoveProjectLabel(ctx context.Context, projectID, labelID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM project_labels WHERE project_id = $1 AND label_id = $2", projectID, labelID)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	return rowsAffected > 0, err
+}
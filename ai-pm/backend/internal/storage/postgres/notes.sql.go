@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/notes.sql
+type noteRepo struct {
+	db dbtx
+}
+
+func scanNote(row interface{ Scan(...interface{}) error }) (storage.Note, error) {
+	var n storage.Note
+	err := row.Scan(&n.ID, &n.ProjectID, &n.TaskID, &n.Content, &n.CreatedAt)
+	return n, err
+}
+
+// name: CreateNote :one
+func (r *noteRepo) Create(ctx context.Context, n storage.Note) (storage.Note, error) {
+	row := r.db.QueryRowContext(ctx,
+		"INSERT INTO notes (project_id, task_id, content) VALUES ($1, $2, $3) RETURNING id, project_id, task_id, content, created_at",
+		n.ProjectID, n.TaskID, n.Content)
+	return scanNote(row)
+}
+
+// name: GetNote :one
+func (r *noteRepo) Get(ctx context.Context, id int) (storage.Note, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT id, project_id, task_id, content, created_at FROM notes WHERE id = $1", id)
+	n, err := scanNote(row)
+	if err == sql.ErrNoRows {
+		return storage.Note{}, storage.ErrNotFound
+	}
+	return n, err
+}
+
+// name: DeleteNote :exec
+func (r *noteRepo) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM notes WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// name: ListNotesForTask :many
+func (r *noteRepo) ListForTask(ctx context.Context, taskID int) ([]storage.Note, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, project_id, task_id, content, created_at FROM notes WHERE task_id = $1 ORDER BY created_at DESC",
+		taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notes := []storage.Note{}
+	for rows.Next() {
+		n, err := scanNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, n)
+	}
+	return notes, rows.Err()
+}
+
+// name: ProjectIDForTask :one
+func (r *noteRepo) ProjectIDForTask(ctx context.Context, taskID int) (int, error) {
+	var projectID int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT project_id FROM tasks WHERE id = $1 AND deleted_at IS NULL", taskID).Scan(&projectID)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	return projectID, err
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/projects.sql
+type projectRepo struct {
+	db dbtx
+}
+
+func scanProject(row interface{ Scan(...interface{}) error }) (storage.Project, error) {
+	var p storage.Project
+	var deletedAt sql.NullTime
+	var deletionReason sql.NullString
+	err := row.Scan(&p.ID, &p.Name, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt, &deletedAt, &deletionReason)
+	if deletedAt.Valid {
+		p.DeletedAt = &deletedAt.Time
+	}
+	if deletionReason.Valid {
+		p.DeletionReason = &deletionReason.String
+	}
+	return p, err
+}
+
+// name: CreateProject :one
+func (r *projectRepo) Create(ctx context.Context, name, description string) (storage.Project, error) {
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO projects (name, description) VALUES ($1, $2)
+		RETURNING id, name, description, status, created_at, updated_at, deleted_at, deletion_reason`,
+		name, description)
+	return scanProject(row)
+}
+
+// name: GetProject :one
+func (r *projectRepo) Get(ctx context.Context, id int) (storage.Project, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT id, name, description, status, created_at, updated_at, deleted_at, deletion_reason
+		FROM projects WHERE id = $1 AND deleted_at IS NULL`, id)
+	p, err := scanProject(row)
+	if err == sql.ErrNoRows {
+		return p, storage.ErrNotFound
+	}
+	return p, err
+}
+
+// UpdateFields is hand-written, not sqlc-generated; see storage.ProjectRepo.
+func (r *projectRepo) UpdateFields(ctx context.Context, id int, fields map[string]interface{}) (storage.Project, error) {
+	setParts := []string{}
+	args := []interface{}{}
+	argCount := 1
+
+	for field, value := range fields {
+		switch field {
+		case "name", "description", "status":
+			setParts = append(setParts, fmt.Sprintf("%s = $%d", field, argCount))
+			args = append(args, value)
+			argCount++
+		}
+	}
+
+	if len(setParts) == 0 {
+		return storage.Project{}, fmt.Errorf("no valid fields to update")
+	}
+
+	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
+	query := fmt.Sprintf(
+		`UPDATE projects SET %s WHERE id = $%d AND deleted_at IS NULL
+		RETURNING id, name, description, status, created_at, updated_at, deleted_at, deletion_reason`,
+		strings.Join(setParts, ", "), argCount)
+	args = append(args, id)
+
+	p, err := scanProject(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return p, storage.ErrNotFound
+	}
+	return p, err
+}
+
+// name: SoftDeleteProject :exec
+func (r *projectRepo) SoftDelete(ctx context.Context, id int, reason string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE projects SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1 WHERE id = $2 AND deleted_at IS NULL",
+		reason, id)
+	return err
+}
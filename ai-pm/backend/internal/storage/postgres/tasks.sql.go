@@ -0,0 +1,356 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ai-pm/backend/internal/storage"
+)
+
+// source: queries/tasks.sql
+type taskRepo struct {
+	db dbtx
+}
+
+func scanTask(row interface{ Scan(...interface{}) error }) (storage.Task, error) {
+	var t storage.Task
+	var isBlocked sql.NullBool
+	var blockedReason sql.NullString
+	var pausedUntil sql.NullTime
+	var resumeStatus sql.NullString
+	var pauseReason sql.NullString
+	var deletedAt sql.NullTime
+	var deletionReason sql.NullString
+	err := row.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority,
+		&isBlocked, &blockedReason, &t.StoryPoints, &t.RetentionSeconds,
+		&pausedUntil, &resumeStatus, &pauseReason, &t.CreatedAt, &t.UpdatedAt,
+		&deletedAt, &deletionReason, &t.ProjectName)
+
+	t.IsBlocked = isBlocked.Valid && isBlocked.Bool
+	t.BlockedReason = blockedReason.String
+	if pausedUntil.Valid {
+		t.PausedUntil = &pausedUntil.Time
+	}
+	t.ResumeStatus = resumeStatus.String
+	t.PauseReason = pauseReason.String
+	if deletedAt.Valid {
+		t.DeletedAt = &deletedAt.Time
+	}
+	if deletionReason.Valid {
+		t.DeletionReason = &deletionReason.String
+	}
+	return t, err
+}
+
+const taskColumns = `t.id, t.project_id, t.title, t.description, t.status, t.priority,
+	t.is_blocked, t.blocked_reason, t.story_points, t.retention_seconds,
+	t.paused_until, t.resume_status, t.pause_reason, t.created_at, t.updated_at,
+	t.deleted_at, t.deletion_reason, p.name`
+
+// name: CreateTask :one
+func (r *taskRepo) Create(ctx context.Context, t storage.Task) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx,
+		`INSERT INTO tasks (project_id, title, description, priority, story_points, retention_seconds)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, status, created_at, updated_at`,
+		t.ProjectID, t.Title, t.Description, t.Priority, t.StoryPoints, t.RetentionSeconds,
+	)
+	if err := row.Scan(&t.ID, &t.Status, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return storage.Task{}, err
+	}
+	return t, nil
+}
+
+// name: GetTask :one
+func (r *taskRepo) Get(ctx context.Context, id int) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		WHERE t.id = $1 AND t.deleted_at IS NULL AND p.deleted_at IS NULL`, taskColumns), id)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: ListDeletedTasks :many
+func (r *taskRepo) ListDeleted(ctx context.Context, projectID *int) ([]storage.Task, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		WHERE t.deleted_at IS NOT NULL AND p.deleted_at IS NULL`, taskColumns)
+	args := []interface{}{}
+	if projectID != nil {
+		query += " AND t.project_id = $1"
+		args = append(args, *projectID)
+	}
+	query += " ORDER BY t.deleted_at DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []storage.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+// UpdateFields is hand-written, not sqlc-generated; see storage.TaskRepo.
+func (r *taskRepo) UpdateFields(ctx context.Context, id int, fields map[string]interface{}) (storage.Task, error) {
+	setParts := []string{}
+	args := []interface{}{}
+	argCount := 1
+
+	for field, value := range fields {
+		switch field {
+		case "title", "description", "status", "priority", "story_points", "retention_seconds", "deleted_at", "deletion_reason":
+			setParts = append(setParts, fmt.Sprintf("%s = $%d", field, argCount))
+			args = append(args, value)
+			argCount++
+		}
+	}
+
+	if len(setParts) == 0 {
+		return storage.Task{}, fmt.Errorf("no valid fields to update")
+	}
+
+	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
+	query := fmt.Sprintf(
+		`UPDATE tasks SET %s WHERE id = $%d
+		RETURNING id, project_id, title, description, status, priority, is_blocked, blocked_reason,
+			story_points, retention_seconds, paused_until, resume_status, pause_reason,
+			created_at, updated_at, deleted_at, deletion_reason,
+			(SELECT name FROM projects WHERE id = tasks.project_id)`,
+		strings.Join(setParts, ", "), argCount)
+	args = append(args, id)
+
+	t, err := scanTask(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: SoftDeleteTask :one
+func (r *taskRepo) SoftDelete(ctx context.Context, id int, reason string) (int, error) {
+	var projectID int
+	err := r.db.QueryRowContext(ctx,
+		`UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1
+		WHERE id = $2 AND deleted_at IS NULL RETURNING project_id`,
+		reason, id,
+	).Scan(&projectID)
+	if err == sql.ErrNoRows {
+		return 0, storage.ErrNotFound
+	}
+	return projectID, err
+}
+
+// name: SoftDeleteTasksByProject :exec
+func (r *taskRepo) SoftDeleteByProject(ctx context.Context, projectID int, reason string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1 WHERE project_id = $2 AND deleted_at IS NULL",
+		reason, projectID)
+	return err
+}
+
+// name: RecoverTask :one
+func (r *taskRepo) Recover(ctx context.Context, id int, status string) (storage.Task, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE tasks SET deleted_at = NULL, deletion_reason = NULL, status = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND deleted_at IS NOT NULL`,
+		status, id)
+	if err != nil {
+		return storage.Task{}, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return storage.Task{}, err
+	}
+	if rowsAffected == 0 {
+		return storage.Task{}, storage.ErrNotFound
+	}
+	return r.Get(ctx, id)
+}
+
+// name: BlockTask :one
+func (r *taskRepo) SetBlocked(ctx context.Context, id int, reason string) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE tasks SET is_blocked = TRUE, blocked_reason = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2
+		RETURNING %s`, strings.Replace(taskColumns, "p.name", "(SELECT name FROM projects WHERE id = tasks.project_id)", 1)),
+		reason, id)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: UnblockTask :one
+func (r *taskRepo) ClearBlocked(ctx context.Context, id int) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE tasks SET is_blocked = FALSE, blocked_reason = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING %s`, strings.Replace(taskColumns, "p.name", "(SELECT name FROM projects WHERE id = tasks.project_id)", 1)),
+		id)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: RecordTaskStatusHistory :exec
+func (r *taskRepo) RecordStatusHistory(ctx context.Context, taskID int, status string) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO task_status_history (task_id, status) VALUES ($1, $2)", taskID, status)
+	return err
+}
+
+// expiryDeadline is the shared expression behind ListExpiring and
+// HardDeleteExpired: a task's deadline is deleted_at + retention once
+// soft-deleted, else updated_at + retention once it reaches "done"; tasks
+// with retention_seconds = 0 never expire and never match either query.
+const expiryDeadline = `
+	(CASE
+		WHEN t.deleted_at IS NOT NULL THEN t.deleted_at + (t.retention_seconds || ' seconds')::interval
+		WHEN t.status = 'done' THEN t.updated_at + (t.retention_seconds || ' seconds')::interval
+		ELSE NULL
+	END)`
+
+// name: ListExpiringTasks :many
+func (r *taskRepo) ListExpiring(ctx context.Context, window time.Duration) ([]storage.Task, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		WHERE t.retention_seconds > 0 AND %s <= $1`, taskColumns, expiryDeadline),
+		time.Now().Add(window))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []storage.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
+
+// name: HardDeleteExpiredTasks :execrows
+func (r *taskRepo) HardDeleteExpired(ctx context.Context) (int, error) {
+	result, err := r.db.ExecContext(ctx, fmt.Sprintf(`
+		DELETE FROM tasks t
+		WHERE t.retention_seconds > 0 AND %s <= CURRENT_TIMESTAMP`, expiryDeadline))
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	return int(rowsAffected), err
+}
+
+// LockExisting is hand-written, not sqlc-generated: the IN list's length is
+// only known at request time, the same reason UpdateFields is hand-written.
+func (r *taskRepo) LockExisting(ctx context.Context, ids []int) (map[int]bool, error) {
+	existing := map[int]bool{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(
+		"SELECT id FROM tasks WHERE id IN (%s) FOR UPDATE", strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		existing[id] = true
+	}
+	return existing, rows.Err()
+}
+
+// name: PauseTask :one
+func (r *taskRepo) Pause(ctx context.Context, id int, until *time.Time, reason string) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE tasks
+		SET resume_status = CASE WHEN status = 'paused' THEN resume_status ELSE status END,
+			status = 'paused', paused_until = $1, pause_reason = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3
+		RETURNING %s`, strings.Replace(taskColumns, "p.name", "(SELECT name FROM projects WHERE id = tasks.project_id)", 1)),
+		until, reason, id)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: ResumeTask :one
+func (r *taskRepo) Resume(ctx context.Context, id int) (storage.Task, error) {
+	row := r.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE tasks
+		SET status = COALESCE(resume_status, 'todo'), paused_until = NULL, resume_status = NULL, pause_reason = NULL,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING %s`, strings.Replace(taskColumns, "p.name", "(SELECT name FROM projects WHERE id = tasks.project_id)", 1)),
+		id)
+	t, err := scanTask(row)
+	if err == sql.ErrNoRows {
+		return t, storage.ErrNotFound
+	}
+	return t, err
+}
+
+// name: ResumeExpiredTasks :many
+func (r *taskRepo) ResumeExpired(ctx context.Context) ([]storage.Task, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		UPDATE tasks
+		SET status = COALESCE(resume_status, 'todo'), paused_until = NULL, resume_status = NULL, pause_reason = NULL,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE status = 'paused' AND paused_until IS NOT NULL AND paused_until <= CURRENT_TIMESTAMP
+		RETURNING %s`, strings.Replace(taskColumns, "p.name", "(SELECT name FROM projects WHERE id = tasks.project_id)", 1)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []storage.Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, rows.Err()
+}
@@ -0,0 +1,332 @@
+// Package storage defines the persistence contracts the ai-pm backend's
+// HTTP handlers depend on, independent of any specific database driver.
+// internal/storage/postgres is the Postgres-backed implementation used in
+// production; a test build can swap in an in-memory one without touching a
+// single handler, and a future MySQL/SQLite backend only has to satisfy
+// these same interfaces.
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Project is the persisted row shape for a project.
+type Project struct {
+	ID             int
+	Name           string
+	Description    string
+	Status         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+	DeletionReason *string
+}
+
+// Task is the persisted row shape for a task.
+type Task struct {
+	ID            int
+	ProjectID     int
+	ProjectName   string
+	Title         string
+	Description   string
+	Status        string
+	Priority      string
+	IsBlocked     bool
+	BlockedReason string
+	StoryPoints   int
+
+	// RetentionSeconds is how long after this task is soft-deleted (or marked
+	// done) it becomes eligible for hard deletion by the sweeper in main.go;
+	// zero means it never expires.
+	RetentionSeconds int
+
+	// PausedUntil/ResumeStatus/PauseReason are set together by Pause and
+	// cleared together by Resume: ResumeStatus captures the status the task
+	// had before it was paused, so Resume (or the auto-resume sweeper, once
+	// PausedUntil elapses) knows what to restore it to.
+	PausedUntil  *time.Time
+	ResumeStatus string
+	PauseReason  string
+
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+	DeletionReason *string
+}
+
+// Note is the persisted row shape for a note.
+type Note struct {
+	ID        int
+	ProjectID int
+	TaskID    *int
+	Content   string
+	CreatedAt time.Time
+}
+
+// Label is the persisted row shape for a label.
+type Label struct {
+	ID        int
+	Key       string
+	Color     string
+	CreatedAt time.Time
+}
+
+// TaskDependency is the persisted row shape for a "task depends on task"
+// edge: TaskID cannot be considered unblocked until DependsOnTaskID reaches
+// the "done" status.
+type TaskDependency struct {
+	ID              int
+	TaskID          int
+	DependsOnTaskID int
+	CreatedAt       time.Time
+}
+
+// GraphNode is one task in a project's dependency graph, as returned by
+// DependencyRepo.GraphForProject.
+type GraphNode struct {
+	TaskID int
+	Title  string
+	Status string
+}
+
+// GraphEdge is one "task depends on task" edge in a project's dependency
+// graph, as returned by DependencyRepo.GraphForProject.
+type GraphEdge struct {
+	TaskID          int
+	DependsOnTaskID int
+}
+
+// ActivityEvent is the persisted row shape for an audit log entry recording
+// a single mutation to a project or task.
+type ActivityEvent struct {
+	ID          int
+	Actor       string
+	ProjectID   int
+	ObjectType  string // "project" or "task"
+	ObjectID    int
+	Action      string // e.g. "created", "updated", "blocked", "deleted"
+	Description string
+	Diff        json.RawMessage
+	CreatedAt   time.Time
+}
+
+// ProjectRepo persists and queries projects.
+type ProjectRepo interface {
+	Create(ctx context.Context, name, description string) (Project, error)
+	Get(ctx context.Context, id int) (Project, error)
+
+	// UpdateFields applies a partial update built from an arbitrary set of
+	// API-supplied fields. It's hand-written rather than sqlc-generated
+	// because sqlc only fits queries whose shape is known at codegen time,
+	// and the set of fields a PATCH touches is only known at request time.
+	UpdateFields(ctx context.Context, id int, fields map[string]interface{}) (Project, error)
+
+	// SoftDelete marks a project deleted. Callers that also need to cascade
+	// the deletion to the project's tasks should do so within a Store.Tx
+	// callback alongside TaskRepo.SoftDeleteByProject.
+	SoftDelete(ctx context.Context, id int, reason string) error
+}
+
+// TaskRepo persists and queries tasks.
+type TaskRepo interface {
+	Create(ctx context.Context, t Task) (Task, error)
+	Get(ctx context.Context, id int) (Task, error)
+	ListDeleted(ctx context.Context, projectID *int) ([]Task, error)
+
+	// UpdateFields applies a partial update built from an arbitrary set of
+	// API-supplied fields; see ProjectRepo.UpdateFields for why this isn't
+	// sqlc-generated.
+	UpdateFields(ctx context.Context, id int, fields map[string]interface{}) (Task, error)
+
+	// SoftDelete marks a single task deleted and returns its project_id, so
+	// callers can scope an event publish without a second lookup.
+	SoftDelete(ctx context.Context, id int, reason string) (projectID int, err error)
+
+	// SoftDeleteByProject cascades a project's own soft delete to every one
+	// of its tasks; only ever called from inside DeleteProject's Store.Tx.
+	SoftDeleteByProject(ctx context.Context, projectID int, reason string) error
+
+	Recover(ctx context.Context, id int, status string) (Task, error)
+	SetBlocked(ctx context.Context, id int, reason string) (Task, error)
+	ClearBlocked(ctx context.Context, id int) (Task, error)
+	RecordStatusHistory(ctx context.Context, taskID int, status string) error
+
+	// ListExpiring returns tasks whose retention deadline (from
+	// deleted_at for soft-deleted tasks, or updated_at for tasks already in
+	// "done") falls within the next window, for GET /api/tasks/expiring.
+	ListExpiring(ctx context.Context, window time.Duration) ([]Task, error)
+
+	// HardDeleteExpired permanently removes soft-deleted tasks whose
+	// deleted_at + retention has passed, and "done" tasks whose
+	// updated_at + retention has passed, returning how many rows were
+	// removed. Called periodically by the sweeper started from main.
+	HardDeleteExpired(ctx context.Context) (int, error)
+
+	// LockExisting takes a row-level lock (SELECT ... FOR UPDATE) on ids
+	// within the caller's transaction and reports which of them exist, so
+	// that concurrent bulk operations touching overlapping ids serialize on
+	// Postgres's row locks instead of racing. Must be called from inside a
+	// Store.Tx.
+	LockExisting(ctx context.Context, ids []int) (map[int]bool, error)
+
+	// Pause sets status to "paused", remembering the task's current status
+	// (unless it's already paused) so Resume/ResumeExpired can restore it.
+	// until is optional; a nil until means the task stays paused until
+	// explicitly resumed.
+	Pause(ctx context.Context, id int, until *time.Time, reason string) (Task, error)
+
+	// Resume restores a paused task to the status it had before Pause.
+	Resume(ctx context.Context, id int) (Task, error)
+
+	// ResumeExpired resumes every paused task whose PausedUntil has elapsed,
+	// returning the tasks it resumed. Called periodically by the sweeper
+	// started from main.
+	ResumeExpired(ctx context.Context) ([]Task, error)
+}
+
+// NoteRepo persists and queries notes.
+type NoteRepo interface {
+	Create(ctx context.Context, n Note) (Note, error)
+	Get(ctx context.Context, id int) (Note, error)
+	Delete(ctx context.Context, id int) error
+	ListForTask(ctx context.Context, taskID int) ([]Note, error)
+	ProjectIDForTask(ctx context.Context, taskID int) (int, error)
+}
+
+// LabelRepo persists and queries labels and their task/project assignments.
+type LabelRepo interface {
+	List(ctx context.Context) ([]Label, error)
+	Create(ctx context.Context, key, color string) (Label, error)
+
+	// ResolveOrCreateID returns the id of the label named key, creating it
+	// (with the default color) if it doesn't exist yet.
+	ResolveOrCreateID(ctx context.Context, key string) (int, error)
+	Get(ctx context.Context, id int) (Label, error)
+
+	ForTask(ctx context.Context, taskID int) ([]Label, error)
+	ForProject(ctx context.Context, projectID int) ([]Label, error)
+
+	// RemoveTaskLabelsByScope/RemoveProjectLabelsByScope delete every label
+	// assignment whose key's scope (everything before its last "/") is
+	// exactly scope, enforcing the one-label-per-scope exclusivity rule from
+	// inside AssignLabel's Store.Tx. Labels of deeper scopes sharing the
+	// prefix are left alone.
+	RemoveTaskLabelsByScope(ctx context.Context, taskID int, scope string) error
+	RemoveProjectLabelsByScope(ctx context.Context, projectID int, scope string) error
+
+	AddTaskLabel(ctx context.Context, taskID, labelID int) error
+	AddProjectLabel(ctx context.Context, projectID, labelID int) error
+	RemoveTaskLabel(ctx context.Context, taskID, labelID int) (removed bool, err error)
+	RemoveProjectLabel(ctx context.Context, projectID, labelID int) (removed bool, err error)
+}
+
+// DependencyRepo persists and queries the task dependency graph.
+type DependencyRepo interface {
+	// Create records that taskID depends on dependsOnTaskID. Callers must run
+	// Reaches(ctx, dependsOnTaskID, taskID) first and reject the request if it
+	// returns true, since inserting the edge would otherwise close a cycle.
+	Create(ctx context.Context, taskID, dependsOnTaskID int) (TaskDependency, error)
+
+	// Delete removes a single dependency edge by its id, scoped to taskID so a
+	// caller can't delete an edge belonging to an unrelated task.
+	Delete(ctx context.Context, taskID, depID int) (removed bool, err error)
+
+	// Upstream returns the tasks taskID depends on; Downstream returns the
+	// tasks that depend on taskID.
+	Upstream(ctx context.Context, taskID int) ([]Task, error)
+	Downstream(ctx context.Context, taskID int) ([]Task, error)
+
+	// Reaches reports whether toTaskID is transitively reachable from
+	// fromTaskID by following depends-on edges - i.e. whether fromTaskID
+	// already (directly or indirectly) depends on toTaskID.
+	Reaches(ctx context.Context, fromTaskID, toTaskID int) (bool, error)
+
+	// UnresolvedCount returns how many of taskID's direct dependencies are not
+	// yet in the "done" status; ProjectManager uses this to auto-derive
+	// is_blocked.
+	UnresolvedCount(ctx context.Context, taskID int) (int, error)
+
+	// GraphForProject returns every task (as a node) and dependency edge
+	// (task_id, depends_on_task_id) for a project's client-side graph view.
+	GraphForProject(ctx context.Context, projectID int) ([]GraphNode, []GraphEdge, error)
+}
+
+// ActivityRepo persists and queries the audit log. Record is called from
+// inside the same Store.Tx as the mutation it documents, so a write and its
+// audit trail commit or roll back together.
+type ActivityRepo interface {
+	Record(ctx context.Context, e ActivityEvent) (ActivityEvent, error)
+
+	// ListForProject/ListForTask return activity newest-first, cursor-paginated
+	// the same way GetTasks/GetProjects/GetNotes are (see listCursor in main).
+	ListForProject(ctx context.Context, projectID int, limit int, before *time.Time, beforeID int) ([]ActivityEvent, int, error)
+	ListForTask(ctx context.Context, taskID int, limit int, before *time.Time, beforeID int) ([]ActivityEvent, int, error)
+}
+
+// Repos bundles one repository per aggregate. Store.Tx hands a handler a
+// Repos bound to a single transaction; ai-pm's top-level Store embeds one
+// bound to the plain (non-transactional) connection.
+type Repos struct {
+	Projects     ProjectRepo
+	Tasks        TaskRepo
+	Notes        NoteRepo
+	Labels       LabelRepo
+	Dependencies DependencyRepo
+	Activity     ActivityRepo
+}
+
+// Store is the top-level persistence handle a backend implementation
+// constructs. Tx runs fn within a single database transaction, giving it a
+// Repos bound to that transaction so multi-step operations - like
+// DeleteProject cascading to its tasks - commit or roll back atomically
+// instead of being expressed as ad-hoc tx.Begin/tx.Exec/tx.Commit in the
+// handler.
+//
+// Store is an interface rather than the Repos struct itself so that swapping
+// backends (Postgres today, an in-memory one in tests, MySQL/SQLite later)
+// only means constructing a different implementation of Tx/Close - each of
+// which embeds its own Repos bound to its own connection type.
+type Store interface {
+	Projects() ProjectRepo
+	Tasks() TaskRepo
+	Notes() NoteRepo
+	Labels() LabelRepo
+	Dependencies() DependencyRepo
+	Activity() ActivityRepo
+
+	Tx(ctx context.Context, fn func(Repos) error) error
+
+	// BulkTx runs fn once per i in [0, n) inside a single enclosing
+	// transaction, isolating each call in its own SAVEPOINT. A real SQL
+	// error from fn(i) only undoes i's statements (recorded as errs[i])
+	// instead of poisoning the whole transaction, which Postgres would
+	// otherwise do and cascade every later i to failure. The transaction
+	// still commits i's that succeeded; only a failure in the
+	// savepoint/commit machinery itself is returned as the second value.
+	BulkTx(ctx context.Context, n int, fn func(repos Repos, i int) error) ([]error, error)
+
+	Close() error
+}
+
+// ErrNotFound is returned by repo methods when the requested row doesn't
+// exist (or is soft-deleted, where the query excludes it).
+var ErrNotFound = newNotFoundError()
+
+func newNotFoundError() error { return notFoundError{} }
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "not found" }
+
+// ErrConflict is returned by repo methods when a write would violate a
+// uniqueness constraint (e.g. creating a label with a key that already
+// exists).
+var ErrConflict = newConflictError()
+
+func newConflictError() error { return conflictError{} }
+
+type conflictError struct{}
+
+func (conflictError) Error() string { return "conflict" }
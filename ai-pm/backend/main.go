@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,13 +13,236 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/ai-pm/backend/internal/storage"
+	"github.com/ai-pm/backend/internal/storage/postgres"
 	"github.com/gorilla/mux"
 	_ "github.com/lib/pq"
 	"github.com/rs/cors"
 )
 
+// Typed error codes returned in every writeJSONError envelope's "code"
+// field, so API clients can switch on a stable machine-readable value
+// instead of parsing human-readable messages.
+const (
+	ErrValidation = "validation_failed"
+	ErrNotFound   = "not_found"
+	ErrConflict   = "conflict"
+	ErrDependency = "dependency_failed"
+	ErrInternal   = "internal_error"
+)
+
+// writeJSONError writes a structured JSON error envelope and logs it keyed
+// by the request's X-Request-ID (set by requestIDMiddleware before the
+// handler runs).
+//
+// detail is always logged in full, but an ErrInternal response never echoes
+// it to the client: handlers pass err.Error() here, which for that code is
+// frequently a raw driver/SQL error, and those can leak schema or query
+// details to an API consumer. Every other code's detail is already a
+// hand-written, client-safe message, so it's returned as-is.
+func writeJSONError(w http.ResponseWriter, status int, code string, detail string) {
+	requestID := w.Header().Get("X-Request-ID")
+	log.Printf("[%s] %d %s: %s", requestID, status, code, detail)
+
+	message := detail
+	if code == ErrInternal {
+		message = "An internal error occurred"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":     status,
+		"code":       code,
+		"message":    message,
+		"request_id": requestID,
+	})
+}
+
+// requestIDMiddleware assigns an X-Request-ID to every request (reusing
+// one the caller already supplied) so it can be correlated across logs and
+// echoed back in every JSON error response.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// generateRequestID returns a random hex-encoded request id, falling back
+// to a timestamp if the system's random source is unavailable.
+func generateRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
+
+// listCursor is the position encoded by an opaque `?cursor=` value: the
+// active sort field, that field's value on the last item of the previous
+// page (string-encoded per its sortSpec.kind), and that item's id as a
+// tiebreaker. Keyset pagination walks whatever order `?sort=` requested, so
+// the cursor must carry the same field or the page boundary is meaningless.
+type listCursor struct {
+	SortField string `json:"sort_field"`
+	SortValue string `json:"sort_value"`
+	ID        int    `json:"id"`
+}
+
+// sortSpec describes how a whitelisted ?sort= field translates to SQL: expr
+// is the expression used for both ORDER BY and the keyset predicate; join is
+// an extra JOIN clause expr depends on ("" if none); kind drives how expr's
+// value is encoded/decoded in a listCursor ("time", "int", or "string").
+type sortSpec struct {
+	expr string
+	join string
+	kind string
+}
+
+// encodeSortValue renders a value scanned from a sort_key column into the
+// string form stored in a listCursor.
+func encodeSortValue(raw interface{}) string {
+	switch v := raw.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// decodeSortValue parses a listCursor's SortValue back into the Go type a
+// sortSpec.kind expects, for binding as a keyset predicate argument.
+func decodeSortValue(kind, value string) (interface{}, error) {
+	switch kind {
+	case "time":
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		return t, nil
+	case "int":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		return n, nil
+	case "float":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		return f, nil
+	default:
+		return value, nil
+	}
+}
+
+func encodeCursor(c listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor")
+	}
+	return c, nil
+}
+
+// listResponse is the envelope returned by cursor-paginated list endpoints,
+// in place of a bare JSON array.
+type listResponse struct {
+	Items      interface{} `json:"items"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Total      int         `json:"total"`
+}
+
+// listParams holds the shared `?limit=`, `?cursor=`, `?sort=`, and `?q=`
+// query params accepted by GetTasks, GetProjects, and GetNotes.
+type listParams struct {
+	limit        int
+	cursor       *listCursor
+	sortField    string
+	sortDesc     bool
+	sortExplicit bool
+	query        string
+}
+
+// parseListParams parses the shared list query params, validating `sort`
+// against specs (the whitelist of fields that handler may order by).
+func parseListParams(r *http.Request, specs map[string]sortSpec, defaultSort string) (listParams, error) {
+	p := listParams{limit: 20, sortField: defaultSort, sortDesc: true}
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return p, fmt.Errorf("limit must be a positive integer")
+		}
+		if limit > 100 {
+			limit = 100
+		}
+		p.limit = limit
+	}
+
+	if sortStr := r.URL.Query().Get("sort"); sortStr != "" {
+		field := strings.TrimPrefix(sortStr, "-")
+		if _, ok := specs[field]; !ok {
+			return p, fmt.Errorf("unsupported sort field %q", field)
+		}
+		p.sortField = field
+		p.sortDesc = strings.HasPrefix(sortStr, "-")
+		p.sortExplicit = true
+	}
+
+	p.query = r.URL.Query().Get("q")
+
+	// A search (?q=) with no explicit ?sort= orders by relevance rather than
+	// defaultSort, so that's the field its cursor is keyed on too - see the
+	// handlers' own "rank" sortSpec override.
+	expectedSortField := p.sortField
+	if p.query != "" && !p.sortExplicit {
+		expectedSortField = "rank"
+	}
+
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		c, err := decodeCursor(cursorStr)
+		if err != nil {
+			return p, err
+		}
+		if c.SortField != expectedSortField {
+			return p, fmt.Errorf("cursor does not match the requested sort field")
+		}
+		p.cursor = &c
+	}
+
+	return p, nil
+}
+
 type Project struct {
 	ID             int        `json:"id"`
 	Name           string     `json:"name"`
@@ -25,23 +252,50 @@ type Project struct {
 	UpdatedAt      time.Time  `json:"updated_at"`
 	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
 	DeletionReason *string    `json:"deletion_reason,omitempty"`
+	Labels         []Label    `json:"labels,omitempty"`
 }
 
 type Task struct {
-	ID             int        `json:"id"`
-	ProjectID      int        `json:"project_id"`
-	ProjectName    string     `json:"project_name,omitempty"`
-	Title          string     `json:"title"`
-	Description    string     `json:"description"`
-	Status         string     `json:"status"`
-	Priority       string     `json:"priority"`
-	IsBlocked      bool       `json:"is_blocked"`
-	BlockedReason  *string    `json:"blocked_reason,omitempty"`
+	ID            int     `json:"id"`
+	ProjectID     int     `json:"project_id"`
+	ProjectName   string  `json:"project_name,omitempty"`
+	Title         string  `json:"title"`
+	Description   string  `json:"description"`
+	Status        string  `json:"status"`
+	Priority      string  `json:"priority"`
+	IsBlocked     bool    `json:"is_blocked"`
+	BlockedReason *string `json:"blocked_reason,omitempty"`
+	StoryPoints   int     `json:"story_points"`
+
+	// RetentionSeconds is how long after this task is soft-deleted or marked
+	// "done" it becomes eligible for hard deletion; zero means it never
+	// expires. See storage.Task.RetentionSeconds.
+	RetentionSeconds int `json:"retention_seconds"`
+
+	// PausedUntil/ResumeStatus/PauseReason are set while the task is on hold;
+	// see storage.Task for their semantics.
+	PausedUntil  *time.Time `json:"paused_until,omitempty"`
+	ResumeStatus *string    `json:"resume_status,omitempty"`
+	PauseReason  *string    `json:"pause_reason,omitempty"`
+
 	CreatedAt      time.Time  `json:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at"`
 	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
 	DeletionReason *string    `json:"deletion_reason,omitempty"`
 	Notes          []Note     `json:"notes,omitempty"`
+	Labels         []Label    `json:"labels,omitempty"`
+}
+
+type Sprint struct {
+	ID        int       `json:"id"`
+	ProjectID int       `json:"project_id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Goal      string    `json:"goal"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type Note struct {
@@ -75,14 +329,205 @@ type PriorityValue struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+type Label struct {
+	ID        int       `json:"id"`
+	Key       string    `json:"key"` // "scope/name", or a bare name for unscoped labels
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TaskDependency is the API shape of a "task depends on task" edge.
+type TaskDependency struct {
+	ID              int       `json:"id"`
+	TaskID          int       `json:"task_id"`
+	DependsOnTaskID int       `json:"depends_on_task_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// TaskDependencies is the response shape of GetTaskDependencies.
+type TaskDependencies struct {
+	Upstream   []Task `json:"upstream"`   // tasks this one depends on
+	Downstream []Task `json:"downstream"` // tasks that depend on this one
+}
+
+// GraphNode/GraphEdge are the response shape of GetProjectGraph, for a
+// client-side dependency graph view.
+type GraphNode struct {
+	TaskID int    `json:"task_id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+type GraphEdge struct {
+	TaskID          int `json:"task_id"`
+	DependsOnTaskID int `json:"depends_on_task_id"`
+}
+
+type ProjectGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+func toAPITaskDependency(d storage.TaskDependency) TaskDependency {
+	return TaskDependency{ID: d.ID, TaskID: d.TaskID, DependsOnTaskID: d.DependsOnTaskID, CreatedAt: d.CreatedAt}
+}
+
+// toAPIProject/toAPITask/toAPINote/toAPILabel adapt internal/storage's
+// persisted row shapes to the API structs above. The two sets of types stay
+// distinct so the storage package never depends on JSON tags or response
+// shape.
+func toAPIProject(p storage.Project) Project {
+	return Project{
+		ID: p.ID, Name: p.Name, Description: p.Description, Status: p.Status,
+		CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+		DeletedAt: p.DeletedAt, DeletionReason: p.DeletionReason,
+	}
+}
+
+func toAPITask(t storage.Task) Task {
+	task := Task{
+		ID: t.ID, ProjectID: t.ProjectID, ProjectName: t.ProjectName,
+		Title: t.Title, Description: t.Description, Status: t.Status, Priority: t.Priority,
+		IsBlocked: t.IsBlocked, StoryPoints: t.StoryPoints,
+		RetentionSeconds: t.RetentionSeconds,
+		CreatedAt:        t.CreatedAt, UpdatedAt: t.UpdatedAt,
+		DeletedAt: t.DeletedAt, DeletionReason: t.DeletionReason,
+	}
+	if t.BlockedReason != "" {
+		task.BlockedReason = &t.BlockedReason
+	}
+	task.PausedUntil = t.PausedUntil
+	if t.ResumeStatus != "" {
+		task.ResumeStatus = &t.ResumeStatus
+	}
+	if t.PauseReason != "" {
+		task.PauseReason = &t.PauseReason
+	}
+	return task
+}
+
+func toAPINote(n storage.Note) Note {
+	return Note{ID: n.ID, ProjectID: n.ProjectID, TaskID: n.TaskID, Content: n.Content, CreatedAt: n.CreatedAt}
+}
+
+func toAPILabel(l storage.Label) Label {
+	return Label{ID: l.ID, Key: l.Key, Color: l.Color, CreatedAt: l.CreatedAt}
+}
+
+func toAPILabels(ls []storage.Label) []Label {
+	labels := make([]Label, 0, len(ls))
+	for _, l := range ls {
+		labels = append(labels, toAPILabel(l))
+	}
+	return labels
+}
+
 type DashboardData struct {
 	TotalProjects int            `json:"total_projects"`
 	TasksByStatus map[string]int `json:"tasks_by_status"`
 	RecentTasks   []Task         `json:"recent_tasks"`
 }
 
+// ActivityEvent is the API shape of an audit log entry returned by
+// GetProjectActivity/GetTaskActivity. It's named ActivityEvent rather than
+// Event to avoid colliding with the SSE Event type below, which is a
+// different, in-process-only notion of "event".
+type ActivityEvent struct {
+	ID          int             `json:"id"`
+	Actor       string          `json:"actor"`
+	ProjectID   int             `json:"project_id"`
+	ObjectType  string          `json:"object_type"`
+	ObjectID    int             `json:"object_id"`
+	Action      string          `json:"action"`
+	Description string          `json:"description"`
+	Diff        json.RawMessage `json:"diff,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+func toAPIActivityEvent(e storage.ActivityEvent) ActivityEvent {
+	return ActivityEvent{
+		ID: e.ID, Actor: e.Actor, ProjectID: e.ProjectID,
+		ObjectType: e.ObjectType, ObjectID: e.ObjectID, Action: e.Action,
+		Description: e.Description, Diff: e.Diff, CreatedAt: e.CreatedAt,
+	}
+}
+
+// actorFromRequest identifies who made a request for the audit log. There's
+// no auth yet, so this just reads an optional caller-supplied header and
+// falls back to "system"; it becomes the seam multi-user auth plugs into.
+func actorFromRequest(r *http.Request) string {
+	if actor := r.Header.Get("X-Actor"); actor != "" {
+		return actor
+	}
+	return "system"
+}
+
+// recordActivity appends an audit log row for a mutation. before/after are
+// marshaled as-is into the stored diff; pass nil for whichever side doesn't
+// apply (e.g. before on a create, after on a delete). Callers run this
+// inside the same Store.Tx as the mutation it documents.
+func recordActivity(ctx context.Context, activity storage.ActivityRepo, r *http.Request, projectID int, objectType string, objectID int, action, description string, before, after interface{}) error {
+	diff, err := json.Marshal(struct {
+		Before interface{} `json:"before,omitempty"`
+		After  interface{} `json:"after,omitempty"`
+	}{Before: before, After: after})
+	if err != nil {
+		return err
+	}
+
+	_, err = activity.Record(ctx, storage.ActivityEvent{
+		Actor:       actorFromRequest(r),
+		ProjectID:   projectID,
+		ObjectType:  objectType,
+		ObjectID:    objectID,
+		Action:      action,
+		Description: description,
+		Diff:        diff,
+	})
+	return err
+}
+
+// truncate shortens s to n runes for use in an activity log description,
+// appending an ellipsis if anything was cut.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
+
+// eventBufferSize bounds how many recent events GetEvents can replay for a
+// reconnecting Last-Event-ID client before it falls back to streaming from
+// "now" only.
+const eventBufferSize = 256
+
+// Event is a single published change, delivered to SSE subscribers as a
+// text/event-stream message.
+type Event struct {
+	ID        int64       `json:"id"`
+	Type      string      `json:"type"`
+	ProjectID int         `json:"project_id"`
+	Data      interface{} `json:"data"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
 type ProjectManager struct {
 	db *sql.DB
+
+	// store is the repository-backed persistence layer for projects,
+	// tasks, notes, and labels (see internal/storage). Sprints, burndown,
+	// the dashboard, and status history still go through db directly -
+	// they weren't part of this migration and can grow their own repo
+	// later.
+	store storage.Store
+
+	// eventsMu guards subscribers, eventBuffer, and nextEventID, the
+	// in-process pub/sub hub backing GetEvents.
+	eventsMu    sync.Mutex
+	subscribers map[chan Event]int // channel -> project_id filter (0 = all projects)
+	eventBuffer []Event
+	nextEventID int64
 }
 
 func NewProjectManager() *ProjectManager {
@@ -105,7 +550,82 @@ func NewProjectManager() *ProjectManager {
 	}
 
 	log.Println("Connected to database successfully")
-	return &ProjectManager{db: db}
+	return &ProjectManager{
+		db:          db,
+		store:       postgres.NewStore(db),
+		subscribers: make(map[chan Event]int),
+		// Seeding from the wall clock instead of 0 means event IDs from this
+		// process run are always greater than any prior run's, so a client
+		// reconnecting with a stale Last-Event-ID from before a restart gets
+		// every event replayed/delivered instead of having them suppressed
+		// until the counter climbs back past it.
+		nextEventID: time.Now().UnixNano(),
+	}
+}
+
+// publish fans an event out to every connected SSE subscriber whose
+// project_id filter matches, and appends it to the replay buffer. Handlers
+// call this after their DB commit has succeeded, never before.
+func (pm *ProjectManager) publish(eventType string, projectID int, data interface{}) {
+	pm.eventsMu.Lock()
+	defer pm.eventsMu.Unlock()
+
+	pm.nextEventID++
+	evt := Event{ID: pm.nextEventID, Type: eventType, ProjectID: projectID, Data: data, CreatedAt: time.Now()}
+
+	pm.eventBuffer = append(pm.eventBuffer, evt)
+	if len(pm.eventBuffer) > eventBufferSize {
+		pm.eventBuffer = pm.eventBuffer[len(pm.eventBuffer)-eventBufferSize:]
+	}
+
+	for ch, filterProjectID := range pm.subscribers {
+		if filterProjectID != 0 && filterProjectID != projectID {
+			continue
+		}
+		select {
+		case ch <- evt:
+		default:
+			// Slow consumer; drop rather than block the publishing handler.
+		}
+	}
+}
+
+// subscribe registers a new SSE client, filtered to projectID (0 = all
+// projects), and returns the channel events will be delivered on.
+func (pm *ProjectManager) subscribe(projectID int) chan Event {
+	ch := make(chan Event, 16)
+	pm.eventsMu.Lock()
+	pm.subscribers[ch] = projectID
+	pm.eventsMu.Unlock()
+	return ch
+}
+
+// unsubscribe removes ch from the subscriber set and closes it.
+func (pm *ProjectManager) unsubscribe(ch chan Event) {
+	pm.eventsMu.Lock()
+	delete(pm.subscribers, ch)
+	pm.eventsMu.Unlock()
+	close(ch)
+}
+
+// eventsSince returns buffered events after lastEventID (exclusive),
+// filtered to projectID (0 = all projects). Used to replay events a client
+// missed while briefly disconnected, per its Last-Event-ID header.
+func (pm *ProjectManager) eventsSince(lastEventID int64, projectID int) []Event {
+	pm.eventsMu.Lock()
+	defer pm.eventsMu.Unlock()
+
+	var replay []Event
+	for _, evt := range pm.eventBuffer {
+		if evt.ID <= lastEventID {
+			continue
+		}
+		if projectID != 0 && evt.ProjectID != projectID {
+			continue
+		}
+		replay = append(replay, evt)
+	}
+	return replay
 }
 
 func getEnv(key, defaultValue string) string {
@@ -115,127 +635,413 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// defaultRetentionSeconds parses DEFAULT_RETENTION (a Go duration string,
+// e.g. "720h") for tasks whose create request doesn't specify its own
+// retention; an update that omits retention_seconds keeps the task's current
+// value. An empty or invalid value means tasks never expire by default.
+func defaultRetentionSeconds() int {
+	d, err := time.ParseDuration(getEnv("DEFAULT_RETENTION", ""))
+	if err != nil {
+		return 0
+	}
+	return int(d.Seconds())
+}
+
+// retentionSweepInterval parses RETENTION_SWEEP_INTERVAL (a Go duration
+// string), defaulting to 1h when unset or invalid.
+func retentionSweepInterval() time.Duration {
+	d, err := time.ParseDuration(getEnv("RETENTION_SWEEP_INTERVAL", "1h"))
+	if err != nil {
+		return time.Hour
+	}
+	return d
+}
+
+// runRetentionSweeper periodically hard-deletes tasks past their retention
+// deadline. It runs for the lifetime of the process; call it with `go` from
+// main.
+func (pm *ProjectManager) runRetentionSweeper() {
+	ticker := time.NewTicker(retentionSweepInterval())
+	defer ticker.Stop()
+
+	for range ticker.C {
+		removed, err := pm.store.Tasks().HardDeleteExpired(context.Background())
+		if err != nil {
+			log.Printf("Warning: retention sweep failed: %v", err)
+			continue
+		}
+		if removed > 0 {
+			log.Printf("Retention sweep hard-deleted %d expired task(s)", removed)
+		}
+	}
+}
+
+// pauseSweepInterval parses PAUSE_SWEEP_INTERVAL (a Go duration string),
+// defaulting to 1m when unset or invalid - tighter than the retention
+// sweep since a paused_until deadline is user-facing ("come back at 3pm")
+// rather than a housekeeping cutoff.
+func pauseSweepInterval() time.Duration {
+	d, err := time.ParseDuration(getEnv("PAUSE_SWEEP_INTERVAL", "1m"))
+	if err != nil {
+		return time.Minute
+	}
+	return d
+}
+
+// runPauseSweeper periodically resumes tasks whose paused_until has
+// elapsed. It runs for the lifetime of the process; call it with `go` from
+// main.
+func (pm *ProjectManager) runPauseSweeper() {
+	ticker := time.NewTicker(pauseSweepInterval())
+	defer ticker.Stop()
+
+	for range ticker.C {
+		resumed, err := pm.store.Tasks().ResumeExpired(context.Background())
+		if err != nil {
+			log.Printf("Warning: pause sweep failed: %v", err)
+			continue
+		}
+		for _, stored := range resumed {
+			t := toAPITask(stored)
+			pm.publish("task.updated", t.ProjectID, t)
+		}
+		if len(resumed) > 0 {
+			log.Printf("Pause sweep auto-resumed %d task(s)", len(resumed))
+		}
+	}
+}
+
+// projectSortSpecs whitelists the fields GetProjects may order by via
+// ?sort= and how each translates to SQL.
+var projectSortSpecs = map[string]sortSpec{
+	"created_at": {expr: "created_at", kind: "time"},
+	"updated_at": {expr: "updated_at", kind: "time"},
+	"name":       {expr: "name", kind: "string"},
+	"status":     {expr: "status", kind: "string"},
+}
+
+// GetProjects lists projects with cursor pagination (?limit=/?cursor=),
+// whitelisted sorting (?sort=), and full-text search over name/description
+// (?q=).
 func (pm *ProjectManager) GetProjects(w http.ResponseWriter, r *http.Request) {
-	rows, err := pm.db.Query("SELECT id, name, description, status, created_at, updated_at FROM projects WHERE deleted_at IS NULL ORDER BY created_at DESC")
+	params, err := parseListParams(r, projectSortSpecs, "created_at")
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	filterConditions := []string{"deleted_at IS NULL"}
+	filterArgs := []interface{}{}
+
+	var rankArgIndex int
+	if params.query != "" {
+		filterConditions = append(filterConditions, fmt.Sprintf("tsv @@ plainto_tsquery('english', $%d)", len(filterArgs)+1))
+		filterArgs = append(filterArgs, params.query)
+		rankArgIndex = len(filterArgs)
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM projects WHERE %s", strings.Join(filterConditions, " AND "))
+	if err := pm.db.QueryRow(countQuery, filterArgs...).Scan(&total); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	rankExpr := "0"
+	if params.query != "" {
+		rankExpr = fmt.Sprintf("ts_rank(tsv, plainto_tsquery('english', $%d))", rankArgIndex)
+	}
+
+	// A search with no explicit ?sort= orders and paginates by relevance
+	// instead of params.sortField, so ranking holds across the full result
+	// set rather than just within one fetched page.
+	spec := projectSortSpecs[params.sortField]
+	sortField := params.sortField
+	if params.query != "" && !params.sortExplicit {
+		sortField = "rank"
+		spec = sortSpec{expr: rankExpr, kind: "float"}
+	}
+	direction := "DESC"
+	op := "<"
+	if !params.sortDesc {
+		direction = "ASC"
+		op = ">"
+	}
+
+	pageConditions := append([]string{}, filterConditions...)
+	pageArgs := append([]interface{}{}, filterArgs...)
+	if params.cursor != nil {
+		sortArg, err := decodeSortValue(spec.kind, params.cursor.SortValue)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+			return
+		}
+		pageConditions = append(pageConditions, fmt.Sprintf("(%s, id) %s ($%d, $%d)", spec.expr, op, len(pageArgs)+1, len(pageArgs)+2))
+		pageArgs = append(pageArgs, sortArg, params.cursor.ID)
+	}
+
+	pageQuery := fmt.Sprintf(`
+		SELECT id, name, description, status, created_at, updated_at, %s AS rank, %s AS sort_key
+		FROM projects
+		%s
+		WHERE %s
+		ORDER BY %s %s, id %s
+		LIMIT $%d`, rankExpr, spec.expr, spec.join, strings.Join(pageConditions, " AND "), spec.expr, direction, direction, len(pageArgs)+1)
+	pageArgs = append(pageArgs, params.limit+1)
+
+	rows, err := pm.db.Query(pageQuery, pageArgs...)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
-	defer rows.Close()
 
-	var projects []Project
+	type scannedProject struct {
+		project Project
+		rank    float64
+		sortKey interface{}
+	}
+
+	var scanned []scannedProject
 	for rows.Next() {
 		var p Project
-		err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt)
+		var rank float64
+		var sortKey interface{}
+		if err := rows.Scan(&p.ID, &p.Name, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt, &rank, &sortKey); err != nil {
+			rows.Close()
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		scanned = append(scanned, scannedProject{project: p, rank: rank, sortKey: sortKey})
+	}
+	rows.Close()
+
+	// The SQL ORDER BY above already reflects the active sort, so the next
+	// cursor comes straight from the last row of this page.
+	hasMore := len(scanned) > params.limit
+	if hasMore {
+		scanned = scanned[:params.limit]
+	}
+
+	var nextCursor string
+	if hasMore && len(scanned) > 0 {
+		last := scanned[len(scanned)-1]
+		nextCursor = encodeCursor(listCursor{SortField: sortField, SortValue: encodeSortValue(last.sortKey), ID: last.project.ID})
+	}
+
+	projects := make([]Project, 0, len(scanned))
+	for _, s := range scanned {
+		labels, err := pm.getLabelsForProject(s.project.ID)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
-		projects = append(projects, p)
+		s.project.Labels = labels
+		projects = append(projects, s.project)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(projects)
+	json.NewEncoder(w).Encode(listResponse{Items: projects, NextCursor: nextCursor, Total: total})
 }
 
 func (pm *ProjectManager) CreateProject(w http.ResponseWriter, r *http.Request) {
 	var p Project
 	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if p.Name == "" {
-		http.Error(w, "Project name is required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Project name is required")
 		return
 	}
 
-	err := pm.db.QueryRow(
-		"INSERT INTO projects (name, description) VALUES ($1, $2) RETURNING id, created_at, updated_at",
-		p.Name, p.Description,
-	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
-
+	var created storage.Project
+	err := pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		created, err = repos.Projects.Create(r.Context(), p.Name, p.Description)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, created.ID, "project", created.ID,
+			"created", fmt.Sprintf("Created project %q", created.Name), nil, created)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 
-	p.Status = "active"
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(p)
+	json.NewEncoder(w).Encode(toAPIProject(created))
 }
 
 func (pm *ProjectManager) GetProject(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	projectID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
 		return
 	}
 
-	var p Project
-	err = pm.db.QueryRow("SELECT id, name, description, status, created_at, updated_at FROM projects WHERE id = $1 AND deleted_at IS NULL", projectID).
-		Scan(&p.ID, &p.Name, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt)
-
+	stored, err := pm.store.Projects().Get(r.Context(), projectID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Project not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Project not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	p := toAPIProject(stored)
+
+	labels, err := pm.getLabelsForProject(p.ID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+	p.Labels = labels
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(p)
 }
 
+// taskSortSpecs whitelists the fields GetTasks may order by via ?sort= and
+// how each translates to SQL. priority/status order by their configured
+// level/sort_order (via priority_values/status_values) rather than the key
+// string, so e.g. ?sort=priority ranks low < medium < high < urgent instead
+// of the lexicographic "high" < "low" < "medium" < "urgent".
+var taskSortSpecs = map[string]sortSpec{
+	"created_at": {expr: "t.created_at", kind: "time"},
+	"updated_at": {expr: "t.updated_at", kind: "time"},
+	"title":      {expr: "t.title", kind: "string"},
+	"priority":   {expr: "pv.level", join: "LEFT JOIN priority_values pv ON pv.key = t.priority", kind: "int"},
+	"status":     {expr: "sv.sort_order", join: "LEFT JOIN status_values sv ON sv.key = t.status", kind: "int"},
+}
+
+// GetTasks lists tasks with cursor pagination (?limit=/?cursor=), whitelisted
+// sorting (?sort=), and full-text search over title/description (?q=), in
+// addition to the existing project_id/status/label filters.
 func (pm *ProjectManager) GetTasks(w http.ResponseWriter, r *http.Request) {
+	params, err := parseListParams(r, taskSortSpecs, "created_at")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
 	projectID := r.URL.Query().Get("project_id")
 	status := r.URL.Query().Get("status")
+	includePaused := r.URL.Query().Get("include_paused") == "true"
+	labelKeys := r.URL.Query()["label"]
 
-	query := `
-		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.is_blocked, t.blocked_reason, t.created_at, t.updated_at, p.name
-		FROM tasks t 
-		JOIN projects p ON t.project_id = p.id 
-		WHERE t.deleted_at IS NULL AND p.deleted_at IS NULL
-	`
-	args := []interface{}{}
-	conditions := []string{}
+	filterConditions := []string{"t.deleted_at IS NULL", "p.deleted_at IS NULL"}
+	filterArgs := []interface{}{}
 
 	if projectID != "" {
-		conditions = append(conditions, fmt.Sprintf("t.project_id = $%d", len(args)+1))
-		args = append(args, projectID)
+		filterConditions = append(filterConditions, fmt.Sprintf("t.project_id = $%d", len(filterArgs)+1))
+		filterArgs = append(filterArgs, projectID)
 	}
 
 	if status != "" {
-		conditions = append(conditions, fmt.Sprintf("t.status = $%d", len(args)+1))
-		args = append(args, status)
+		filterConditions = append(filterConditions, fmt.Sprintf("t.status = $%d", len(filterArgs)+1))
+		filterArgs = append(filterArgs, status)
+	} else if !includePaused {
+		// Paused tasks are deliberately on hold, so they're hidden from the
+		// default task list (and thus active-work dashboards) unless asked
+		// for explicitly, either by status=paused or include_paused=true.
+		filterConditions = append(filterConditions, "t.status != 'paused'")
+	}
+
+	// A task must carry every requested label
+	for _, labelKey := range labelKeys {
+		filterConditions = append(filterConditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM task_labels tl JOIN labels l ON l.id = tl.label_id WHERE tl.task_id = t.id AND l.key = $%d)",
+			len(filterArgs)+1))
+		filterArgs = append(filterArgs, labelKey)
+	}
+
+	var rankArgIndex int
+	if params.query != "" {
+		filterConditions = append(filterConditions, fmt.Sprintf("t.tsv @@ plainto_tsquery('english', $%d)", len(filterArgs)+1))
+		filterArgs = append(filterArgs, params.query)
+		rankArgIndex = len(filterArgs)
+	}
+
+	var total int
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		WHERE %s`, strings.Join(filterConditions, " AND "))
+	if err := pm.db.QueryRow(countQuery, filterArgs...).Scan(&total); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
 	}
 
-	if len(conditions) > 0 {
-		for _, condition := range conditions {
-			query += " AND " + condition
+	rankExpr := "0"
+	if params.query != "" {
+		rankExpr = fmt.Sprintf("ts_rank(t.tsv, plainto_tsquery('english', $%d))", rankArgIndex)
+	}
+
+	// A search with no explicit ?sort= orders and paginates by relevance
+	// instead of params.sortField, so ranking holds across the full result
+	// set rather than just within one fetched page.
+	spec := taskSortSpecs[params.sortField]
+	sortField := params.sortField
+	if params.query != "" && !params.sortExplicit {
+		sortField = "rank"
+		spec = sortSpec{expr: rankExpr, kind: "float"}
+	}
+	direction := "DESC"
+	op := "<"
+	if !params.sortDesc {
+		direction = "ASC"
+		op = ">"
+	}
+
+	pageConditions := append([]string{}, filterConditions...)
+	pageArgs := append([]interface{}{}, filterArgs...)
+	if params.cursor != nil {
+		sortArg, err := decodeSortValue(spec.kind, params.cursor.SortValue)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+			return
 		}
+		pageConditions = append(pageConditions, fmt.Sprintf("(%s, t.id) %s ($%d, $%d)", spec.expr, op, len(pageArgs)+1, len(pageArgs)+2))
+		pageArgs = append(pageArgs, sortArg, params.cursor.ID)
 	}
 
-	query += " ORDER BY t.created_at DESC"
+	pageQuery := fmt.Sprintf(`
+		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.is_blocked, t.blocked_reason, t.story_points, t.created_at, t.updated_at, p.name, %s AS rank, %s AS sort_key
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		%s
+		WHERE %s
+		ORDER BY %s %s, t.id %s
+		LIMIT $%d`, rankExpr, spec.expr, spec.join, strings.Join(pageConditions, " AND "), spec.expr, direction, direction, len(pageArgs)+1)
+	pageArgs = append(pageArgs, params.limit+1)
 
-	rows, err := pm.db.Query(query, args...)
+	rows, err := pm.db.Query(pageQuery, pageArgs...)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
-	defer rows.Close()
 
-	var tasks []Task
+	type scannedTask struct {
+		task    Task
+		rank    float64
+		sortKey interface{}
+	}
+
+	var scanned []scannedTask
 	for rows.Next() {
 		var t Task
 		var isBlocked sql.NullBool
-		err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &isBlocked, &t.BlockedReason, &t.CreatedAt, &t.UpdatedAt, &t.ProjectName)
+		var rank float64
+		var sortKey interface{}
+		err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &isBlocked, &t.BlockedReason, &t.StoryPoints, &t.CreatedAt, &t.UpdatedAt, &t.ProjectName, &rank, &sortKey)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			rows.Close()
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
 
@@ -246,30 +1052,55 @@ func (pm *ProjectManager) GetTasks(w http.ResponseWriter, r *http.Request) {
 			t.IsBlocked = false
 		}
 
-		// Fetch notes for this task
-		notes, err := pm.getNotesForTask(t.ID)
+		scanned = append(scanned, scannedTask{task: t, rank: rank, sortKey: sortKey})
+	}
+	rows.Close()
+
+	// The SQL ORDER BY above already reflects the active sort, so the next
+	// cursor comes straight from the last row of this page.
+	hasMore := len(scanned) > params.limit
+	if hasMore {
+		scanned = scanned[:params.limit]
+	}
+
+	var nextCursor string
+	if hasMore && len(scanned) > 0 {
+		last := scanned[len(scanned)-1]
+		nextCursor = encodeCursor(listCursor{SortField: sortField, SortValue: encodeSortValue(last.sortKey), ID: last.task.ID})
+	}
+
+	tasks := make([]Task, 0, len(scanned))
+	for _, s := range scanned {
+		notes, err := pm.getNotesForTask(s.task.ID)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
-		t.Notes = notes
+		s.task.Notes = notes
 
-		tasks = append(tasks, t)
+		labels, err := pm.getLabelsForTask(s.task.ID)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		s.task.Labels = labels
+
+		tasks = append(tasks, s.task)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(tasks)
+	json.NewEncoder(w).Encode(listResponse{Items: tasks, NextCursor: nextCursor, Total: total})
 }
 
 func (pm *ProjectManager) CreateTask(w http.ResponseWriter, r *http.Request) {
 	var t Task
 	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if t.ProjectID == 0 || t.Title == "" {
-		http.Error(w, "Project ID and title are required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Project ID and title are required")
 		return
 	}
 
@@ -277,18 +1108,44 @@ func (pm *ProjectManager) CreateTask(w http.ResponseWriter, r *http.Request) {
 		t.Priority = "medium"
 	}
 
-	err := pm.db.QueryRow(
-		"INSERT INTO tasks (project_id, title, description, priority) VALUES ($1, $2, $3, $4) RETURNING id, status, created_at, updated_at",
-		t.ProjectID, t.Title, t.Description, t.Priority,
-	).Scan(&t.ID, &t.Status, &t.CreatedAt, &t.UpdatedAt)
+	retentionSeconds := t.RetentionSeconds
+	if retentionSeconds == 0 {
+		retentionSeconds = defaultRetentionSeconds()
+	}
 
+	var created storage.Task
+	err := pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		created, err = repos.Tasks.Create(r.Context(), storage.Task{
+			ProjectID:        t.ProjectID,
+			Title:            t.Title,
+			Description:      t.Description,
+			Priority:         t.Priority,
+			StoryPoints:      t.StoryPoints,
+			RetentionSeconds: retentionSeconds,
+		})
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, created.ProjectID, "task", created.ID,
+			"created", fmt.Sprintf("Created task %q", created.Title), nil, created)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
+	t = toAPITask(created)
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	// Seed status history so burndown charts can reconstruct this task's
+	// state from the moment it was created
+	if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, t.Status); err != nil {
+		log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+	}
+
+	pm.publish("task.created", t.ProjectID, t)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(t)
 }
 
@@ -296,26 +1153,27 @@ func (pm *ProjectManager) GetTask(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
 		return
 	}
 
-	var t Task
-	err = pm.db.QueryRow(`
-		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.created_at, t.updated_at, p.name
-		FROM tasks t 
-		JOIN projects p ON t.project_id = p.id 
-		WHERE t.id = $1 AND t.deleted_at IS NULL AND p.deleted_at IS NULL`, taskID).
-		Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &t.CreatedAt, &t.UpdatedAt, &t.ProjectName)
-
+	stored, err := pm.store.Tasks().Get(r.Context(), taskID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Task not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	t := toAPITask(stored)
+
+	labels, err := pm.getLabelsForTask(t.ID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+	t.Labels = labels
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(t)
@@ -325,103 +1183,332 @@ func (pm *ProjectManager) UpdateTask(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
 		return
 	}
 
 	var updates map[string]interface{}
 	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
-	// Build dynamic update query
-	setParts := []string{}
-	args := []interface{}{}
-	argCount := 1
+	// Pausing has to go through PauseTask so resume_status/paused_until are
+	// set consistently; a bare status="paused" here would leave Resume with
+	// nothing to restore.
+	if status, ok := updates["status"].(string); ok && status == "paused" {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Use POST /tasks/{id}/pause to pause a task")
+		return
+	}
+
+	fields := map[string]interface{}{}
 
 	// Handle soft deletion
 	isDeleting := false
 	if status, ok := updates["status"].(string); ok && status == "deleted" {
 		isDeleting = true
-		setParts = append(setParts, fmt.Sprintf("status = $%d", argCount))
-		args = append(args, status)
-		argCount++
-
-		setParts = append(setParts, fmt.Sprintf("deleted_at = $%d", argCount))
-		args = append(args, time.Now())
-		argCount++
+		fields["status"] = status
+		fields["deleted_at"] = time.Now()
 
 		if deletionReason, ok := updates["deletion_reason"].(string); ok && deletionReason != "" {
-			setParts = append(setParts, fmt.Sprintf("deletion_reason = $%d", argCount))
-			args = append(args, deletionReason)
-			argCount++
+			fields["deletion_reason"] = deletionReason
 		}
 	}
 
 	// Handle regular field updates (skip if we're deleting)
+	statusChanged := isDeleting
 	if !isDeleting {
 		for field, value := range updates {
 			switch field {
-			case "title", "description", "status", "priority":
-				setParts = append(setParts, fmt.Sprintf("%s = $%d", field, argCount))
-				args = append(args, value)
-				argCount++
+			case "title", "description", "status", "priority", "story_points", "retention_seconds":
+				fields[field] = value
+				if field == "status" {
+					statusChanged = true
+				}
 			}
 		}
 	}
 
-	if len(setParts) == 0 {
-		http.Error(w, "No valid fields to update", http.StatusBadRequest)
+	if len(fields) == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "No valid fields to update")
 		return
 	}
 
-	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
-	args = append(args, taskID)
+	var stored storage.Task
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		before, err := repos.Tasks.Get(r.Context(), taskID)
+		if err != nil {
+			return err
+		}
 
-	query := fmt.Sprintf("UPDATE tasks SET %s WHERE id = $%d RETURNING id, project_id, title, description, status, priority, is_blocked, blocked_reason, created_at, updated_at, deleted_at, deletion_reason",
-		strings.Join(setParts, ", "), argCount)
+		stored, err = repos.Tasks.UpdateFields(r.Context(), taskID, fields)
+		if err != nil {
+			return err
+		}
 
-	var t Task
-	var isBlocked sql.NullBool
-	var deletedAt sql.NullTime
-	var deletionReason sql.NullString
-	err = pm.db.QueryRow(query, args...).Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &isBlocked, &t.BlockedReason, &t.CreatedAt, &t.UpdatedAt, &deletedAt, &deletionReason)
+		action := "updated"
+		description := fmt.Sprintf("Updated task %q", stored.Title)
+		if isDeleting {
+			action = "deleted"
+			description = fmt.Sprintf("Deleted task %q", stored.Title)
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			action, description, before, stored); err != nil {
+			return err
+		}
+
+		// A status change (including deletion) may resolve or reopen
+		// downstream tasks' dependency on this one.
+		if statusChanged {
+			downstream, err := repos.Dependencies.Downstream(r.Context(), taskID)
+			if err != nil {
+				return err
+			}
+			for _, d := range downstream {
+				if err := syncBlockedFromDependencies(r.Context(), repos, d.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Task not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	t := toAPITask(stored)
+
+	if statusChanged {
+		if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, t.Status); err != nil {
+			log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+		}
+	}
 
-	// Handle nullable boolean - default to false if null
-	if isBlocked.Valid {
-		t.IsBlocked = isBlocked.Bool
+	if isDeleting {
+		pm.publish("task.deleted", t.ProjectID, t)
 	} else {
-		t.IsBlocked = false
+		pm.publish("task.updated", t.ProjectID, t)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t)
+}
+
+// maxBulkTaskBatch caps how many ids BulkUpdateTasks will process in one
+// request, so a single request can't hold row locks on (or build a query
+// against) an unbounded number of tasks.
+const maxBulkTaskBatch = 500
+
+// BulkTaskRequest is the POST /api/tasks/bulk request body: action is one of
+// "delete", "recover", "block", "unblock", "update_status", "update_priority",
+// and payload carries whatever that action needs (e.g. {"reason": "..."} for
+// delete/block, {"status": "..."} for update_status).
+type BulkTaskRequest struct {
+	IDs     []int                  `json:"ids"`
+	Action  string                 `json:"action"`
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// BulkTaskResult reports one id's outcome from a BulkUpdateTasks request.
+type BulkTaskResult struct {
+	ID      int    `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// BulkUpdateTasks applies the same action to many tasks in a single
+// transaction: it locks every requested id up front (LockExisting) so
+// overlapping bulk requests serialize on Postgres's row locks rather than
+// interleaving, then applies the action id-by-id under its own SAVEPOINT
+// (via Store.BulkTx) so a real SQL error on one id rolls back only that
+// id instead of poisoning the transaction for every id after it,
+// recording a per-id success/failure result instead of failing the whole
+// batch on one bad id.
+func (pm *ProjectManager) BulkUpdateTasks(w http.ResponseWriter, r *http.Request) {
+	var req BulkTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	if len(req.IDs) == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "ids is required")
+		return
+	}
+	if len(req.IDs) > maxBulkTaskBatch {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation,
+			fmt.Sprintf("at most %d ids allowed per bulk request", maxBulkTaskBatch))
+		return
 	}
 
-	// Handle nullable timestamp
-	if deletedAt.Valid {
-		t.DeletedAt = &deletedAt.Time
+	type bulkPublish struct {
+		event string
+		task  Task
+	}
+	var toPublish []bulkPublish
+
+	// existing is populated by a LockExisting call shared across every id
+	// (see below), so it has to live outside the per-id BulkTx closure.
+	var existing map[int]bool
+	results := make([]BulkTaskResult, len(req.IDs))
+	_, err := pm.store.BulkTx(r.Context(), len(req.IDs), func(repos storage.Repos, i int) error {
+		if existing == nil {
+			locked, err := repos.Tasks.LockExisting(r.Context(), req.IDs)
+			if err != nil {
+				return err
+			}
+			existing = locked
+		}
+
+		id := req.IDs[i]
+		if !existing[id] {
+			results[i] = BulkTaskResult{ID: id, Success: false, Error: "task not found"}
+			return nil
+		}
+
+		stored, event, err := pm.applyBulkTaskAction(r, repos, id, req.Action, req.Payload)
+		if err != nil {
+			results[i] = BulkTaskResult{ID: id, Success: false, Error: err.Error()}
+			return err
+		}
+
+		results[i] = BulkTaskResult{ID: id, Success: true}
+		if event != "" {
+			toPublish = append(toPublish, bulkPublish{event: event, task: toAPITask(stored)})
+		}
+		return nil
+	})
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
 	}
 
-	// Handle nullable deletion reason
-	if deletionReason.Valid {
-		t.DeletionReason = &deletionReason.String
+	for _, p := range toPublish {
+		pm.publish(p.event, p.task.ProjectID, p.task)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(t)
+	json.NewEncoder(w).Encode(results)
+}
+
+// applyBulkTaskAction performs one bulk action against a single already-
+// locked task inside the caller's transaction, recording activity the same
+// way the corresponding single-task handler does. The returned event name
+// is what BulkUpdateTasks publishes once the batch commits; an empty event
+// means the action has no full row to publish (delete).
+func (pm *ProjectManager) applyBulkTaskAction(r *http.Request, repos storage.Repos, taskID int, action string, payload map[string]interface{}) (storage.Task, string, error) {
+	switch action {
+	case "delete":
+		reason, _ := payload["reason"].(string)
+		if reason == "" {
+			reason = "bulk delete"
+		}
+		projectID, err := repos.Tasks.SoftDelete(r.Context(), taskID, reason)
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, projectID, "task", taskID,
+			"deleted", "Deleted task: "+reason, nil, nil); err != nil {
+			return storage.Task{}, "", err
+		}
+		return storage.Task{}, "", nil
+
+	case "recover":
+		status, _ := payload["status"].(string)
+		if status == "" {
+			status = "todo"
+		}
+		stored, err := repos.Tasks.Recover(r.Context(), taskID, status)
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"recovered", fmt.Sprintf("Recovered task %q", stored.Title), nil, stored); err != nil {
+			return storage.Task{}, "", err
+		}
+		return stored, "task.updated", nil
+
+	case "block":
+		reason, _ := payload["reason"].(string)
+		if reason == "" {
+			return storage.Task{}, "", fmt.Errorf("payload.reason is required to block a task")
+		}
+		stored, err := repos.Tasks.SetBlocked(r.Context(), taskID, reason)
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"blocked", fmt.Sprintf("Blocked task %q: %s", stored.Title, reason), nil, stored); err != nil {
+			return storage.Task{}, "", err
+		}
+		return stored, "task.updated", nil
+
+	case "unblock":
+		stored, err := repos.Tasks.ClearBlocked(r.Context(), taskID)
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"unblocked", fmt.Sprintf("Unblocked task %q", stored.Title), nil, nil); err != nil {
+			return storage.Task{}, "", err
+		}
+		return stored, "task.updated", nil
+
+	case "update_status", "update_priority":
+		field := "status"
+		if action == "update_priority" {
+			field = "priority"
+		}
+		value, ok := payload[field].(string)
+		if !ok || value == "" {
+			return storage.Task{}, "", fmt.Errorf("payload.%s is required", field)
+		}
+		if field == "status" && value == "paused" {
+			return storage.Task{}, "", fmt.Errorf("use POST /tasks/{id}/pause, not update_status, to pause a task")
+		}
+
+		before, err := repos.Tasks.Get(r.Context(), taskID)
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		stored, err := repos.Tasks.UpdateFields(r.Context(), taskID, map[string]interface{}{field: value})
+		if err != nil {
+			return storage.Task{}, "", err
+		}
+		if err := recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"updated", fmt.Sprintf("Updated task %q", stored.Title), before, stored); err != nil {
+			return storage.Task{}, "", err
+		}
+
+		// A status change may resolve or reopen downstream tasks' dependency
+		// on this one, same as UpdateTask's single-task path.
+		if field == "status" {
+			downstream, err := repos.Dependencies.Downstream(r.Context(), taskID)
+			if err != nil {
+				return storage.Task{}, "", err
+			}
+			for _, d := range downstream {
+				if err := syncBlockedFromDependencies(r.Context(), repos, d.ID); err != nil {
+					return storage.Task{}, "", err
+				}
+			}
+		}
+		return stored, "task.updated", nil
+
+	default:
+		return storage.Task{}, "", fmt.Errorf("unknown action %q", action)
+	}
 }
 
 func (pm *ProjectManager) BlockTask(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
 		return
 	}
 
@@ -429,28 +1516,43 @@ func (pm *ProjectManager) BlockTask(w http.ResponseWriter, r *http.Request) {
 		Reason string `json:"reason"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if requestBody.Reason == "" {
-		http.Error(w, "Reason is required for blocking a task", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Reason is required for blocking a task")
 		return
 	}
 
-	query := `UPDATE tasks SET is_blocked = TRUE, blocked_reason = $1, updated_at = CURRENT_TIMESTAMP 
-			  WHERE id = $2 RETURNING id, project_id, title, description, status, priority, is_blocked, blocked_reason, created_at, updated_at`
-
-	var t Task
-	err = pm.db.QueryRow(query, requestBody.Reason, taskID).Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &t.IsBlocked, &t.BlockedReason, &t.CreatedAt, &t.UpdatedAt)
+	var stored storage.Task
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		stored, err = repos.Tasks.SetBlocked(r.Context(), taskID, requestBody.Reason)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"blocked", fmt.Sprintf("Blocked task %q: %s", stored.Title, requestBody.Reason), nil, requestBody.Reason)
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Task not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	t := toAPITask(stored)
+
+	// Record "blocked" as a pseudo-status so burndown charts can tell a task
+	// was blocked on a given day, even though the underlying status column
+	// (todo/in_progress/etc.) doesn't change
+	if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, "blocked"); err != nil {
+		log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+	}
+
+	pm.publish("task.blocked", t.ProjectID, t)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(t)
@@ -460,23 +1562,132 @@ func (pm *ProjectManager) UnblockTask(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	var stored storage.Task
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		stored, err = repos.Tasks.ClearBlocked(r.Context(), taskID)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"unblocked", fmt.Sprintf("Unblocked task %q", stored.Title), nil, nil)
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
 		return
 	}
+	t := toAPITask(stored)
+
+	// Record the task's real status again now that it's no longer blocked
+	if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, t.Status); err != nil {
+		log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+	}
 
-	query := `UPDATE tasks SET is_blocked = FALSE, blocked_reason = NULL, updated_at = CURRENT_TIMESTAMP 
-			  WHERE id = $1 RETURNING id, project_id, title, description, status, priority, is_blocked, blocked_reason, created_at, updated_at`
+	pm.publish("task.updated", t.ProjectID, t)
 
-	var t Task
-	err = pm.db.QueryRow(query, taskID).Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &t.IsBlocked, &t.BlockedReason, &t.CreatedAt, &t.UpdatedAt)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t)
+}
+
+// PauseTask puts a task deliberately on hold, distinct from BlockTask (which
+// is meant for external blockers): the task's current status is remembered
+// so Resume or the auto-resume sweeper can restore it once until elapses.
+func (pm *ProjectManager) PauseTask(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Task not found", http.StatusNotFound)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	var requestBody struct {
+		Until  *time.Time `json:"until"`
+		Reason string     `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	if requestBody.Reason == "" {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Reason is required for pausing a task")
+		return
+	}
+
+	var stored storage.Task
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		stored, err = repos.Tasks.Pause(r.Context(), taskID, requestBody.Until, requestBody.Reason)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"paused", fmt.Sprintf("Paused task %q: %s", stored.Title, requestBody.Reason), nil, stored)
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+	t := toAPITask(stored)
+
+	if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, t.Status); err != nil {
+		log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+	}
+
+	pm.publish("task.updated", t.ProjectID, t)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(t)
+}
+
+// ResumeTask immediately restores a paused task to the status it had before
+// PauseTask, the same transition the auto-resume sweeper makes once
+// paused_until elapses on its own.
+func (pm *ProjectManager) ResumeTask(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	var stored storage.Task
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		stored, err = repos.Tasks.Resume(r.Context(), taskID)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, stored.ProjectID, "task", stored.ID,
+			"resumed", fmt.Sprintf("Resumed task %q", stored.Title), nil, stored)
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	t := toAPITask(stored)
+
+	if err := pm.store.Tasks().RecordStatusHistory(r.Context(), t.ID, t.Status); err != nil {
+		log.Printf("Warning: failed to record task status history for task %d: %v", t.ID, err)
+	}
+
+	pm.publish("task.updated", t.ProjectID, t)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(t)
@@ -486,50 +1697,55 @@ func (pm *ProjectManager) UpdateProject(w http.ResponseWriter, r *http.Request)
 	vars := mux.Vars(r)
 	projectID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
 		return
 	}
 
 	var updates map[string]interface{}
 	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
-	// Build dynamic update query
-	setParts := []string{}
-	args := []interface{}{}
-	argCount := 1
-
+	fields := map[string]interface{}{}
 	for field, value := range updates {
 		switch field {
 		case "name", "description", "status":
-			setParts = append(setParts, fmt.Sprintf("%s = $%d", field, argCount))
-			args = append(args, value)
-			argCount++
+			fields[field] = value
 		}
 	}
 
-	if len(setParts) == 0 {
-		http.Error(w, "No valid fields to update", http.StatusBadRequest)
+	if len(fields) == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "No valid fields to update")
 		return
 	}
 
-	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
-	query := fmt.Sprintf("UPDATE projects SET %s WHERE id = $%d AND deleted_at IS NULL RETURNING id, name, description, status, created_at, updated_at",
-		strings.Join(setParts, ", "), argCount)
-	args = append(args, projectID)
+	var stored storage.Project
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		before, err := repos.Projects.Get(r.Context(), projectID)
+		if err != nil {
+			return err
+		}
 
-	var p Project
-	err = pm.db.QueryRow(query, args...).Scan(&p.ID, &p.Name, &p.Description, &p.Status, &p.CreatedAt, &p.UpdatedAt)
+		stored, err = repos.Projects.UpdateFields(r.Context(), projectID, fields)
+		if err != nil {
+			return err
+		}
+
+		return recordActivity(r.Context(), repos.Activity, r, stored.ID, "project", stored.ID,
+			"updated", fmt.Sprintf("Updated project %q", stored.Name), before, stored)
+	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			http.Error(w, "Project not found", http.StatusNotFound)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Project not found")
 		} else {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
 		return
 	}
+	p := toAPIProject(stored)
+
+	pm.publish("project.updated", p.ID, p)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(p)
@@ -540,7 +1756,7 @@ func (pm *ProjectManager) DeleteProject(w http.ResponseWriter, r *http.Request)
 	vars := mux.Vars(r)
 	projectID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
 		return
 	}
 
@@ -548,41 +1764,28 @@ func (pm *ProjectManager) DeleteProject(w http.ResponseWriter, r *http.Request)
 		Reason string `json:"reason"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&deleteRequest); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if deleteRequest.Reason == "" {
-		http.Error(w, "Deletion reason is required", http.StatusBadRequest)
-		return
-	}
-
-	// Soft delete the project and all its tasks
-	tx, err := pm.db.Begin()
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Deletion reason is required")
 		return
 	}
-	defer tx.Rollback()
 
-	// Update project
-	_, err = tx.Exec("UPDATE projects SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1 WHERE id = $2 AND deleted_at IS NULL",
-		deleteRequest.Reason, projectID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	// Update all tasks in the project
-	_, err = tx.Exec("UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1 WHERE project_id = $2 AND deleted_at IS NULL",
-		"Project deleted: "+deleteRequest.Reason, projectID)
+	// Soft delete the project and cascade to all its tasks in one transaction.
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		if err := repos.Projects.SoftDelete(r.Context(), projectID, deleteRequest.Reason); err != nil {
+			return err
+		}
+		if err := repos.Tasks.SoftDeleteByProject(r.Context(), projectID, "Project deleted: "+deleteRequest.Reason); err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, projectID, "project", projectID,
+			"deleted", "Deleted project: "+deleteRequest.Reason, nil, deleteRequest.Reason)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 
@@ -594,7 +1797,7 @@ func (pm *ProjectManager) DeleteTask(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
 		return
 	}
 
@@ -602,114 +1805,224 @@ func (pm *ProjectManager) DeleteTask(w http.ResponseWriter, r *http.Request) {
 		Reason string `json:"reason"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&deleteRequest); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if deleteRequest.Reason == "" {
-		http.Error(w, "Deletion reason is required", http.StatusBadRequest)
-		return
-	}
-
-	result, err := pm.db.Exec("UPDATE tasks SET deleted_at = CURRENT_TIMESTAMP, deletion_reason = $1 WHERE id = $2 AND deleted_at IS NULL",
-		deleteRequest.Reason, taskID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Deletion reason is required")
 		return
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	var projectID int
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		projectID, err = repos.Tasks.SoftDelete(r.Context(), taskID, deleteRequest.Reason)
+		if err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, projectID, "task", taskID,
+			"deleted", "Deleted task: "+deleteRequest.Reason, nil, nil)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
 		return
 	}
 
-	if rowsAffected == 0 {
-		http.Error(w, "Task not found", http.StatusNotFound)
-		return
-	}
+	pm.publish("task.deleted", projectID, map[string]interface{}{"id": taskID, "project_id": projectID, "reason": deleteRequest.Reason})
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// GetNotes retrieves notes, optionally filtered by task_id
+// noteSortSpecs whitelists the fields GetNotes may order by via ?sort=.
+// created_at is the only sortable field.
+var noteSortSpecs = map[string]sortSpec{
+	"created_at": {expr: "n.created_at", kind: "time"},
+}
+
+// GetNotes retrieves notes, optionally filtered by task_id, with cursor
+// pagination (?limit=/?cursor=) and full-text search over content (?q=).
 func (pm *ProjectManager) GetNotes(w http.ResponseWriter, r *http.Request) {
+	params, err := parseListParams(r, noteSortSpecs, "created_at")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
 	taskID := r.URL.Query().Get("task_id")
 
-	query := `
-		SELECT n.id, n.project_id, n.task_id, n.content, n.created_at
-		FROM notes n
-		WHERE 1=1
-	`
-	args := []interface{}{}
+	filterConditions := []string{"1=1"}
+	filterArgs := []interface{}{}
 
 	if taskID != "" {
-		query += " AND n.task_id = $1"
-		args = append(args, taskID)
+		filterConditions = append(filterConditions, fmt.Sprintf("n.task_id = $%d", len(filterArgs)+1))
+		filterArgs = append(filterArgs, taskID)
+	}
+
+	var rankArgIndex int
+	if params.query != "" {
+		filterConditions = append(filterConditions, fmt.Sprintf("n.tsv @@ plainto_tsquery('english', $%d)", len(filterArgs)+1))
+		filterArgs = append(filterArgs, params.query)
+		rankArgIndex = len(filterArgs)
 	}
 
-	query += " ORDER BY n.created_at DESC"
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM notes n WHERE %s", strings.Join(filterConditions, " AND "))
+	if err := pm.db.QueryRow(countQuery, filterArgs...).Scan(&total); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
 
-	rows, err := pm.db.Query(query, args...)
+	rankExpr := "0"
+	if params.query != "" {
+		rankExpr = fmt.Sprintf("ts_rank(n.tsv, plainto_tsquery('english', $%d))", rankArgIndex)
+	}
+
+	// A search with no explicit ?sort= orders and paginates by relevance
+	// instead of params.sortField, so ranking holds across the full result
+	// set rather than just within one fetched page.
+	spec := noteSortSpecs[params.sortField]
+	sortField := params.sortField
+	if params.query != "" && !params.sortExplicit {
+		sortField = "rank"
+		spec = sortSpec{expr: rankExpr, kind: "float"}
+	}
+	direction := "DESC"
+	op := "<"
+	if !params.sortDesc {
+		direction = "ASC"
+		op = ">"
+	}
+
+	pageConditions := append([]string{}, filterConditions...)
+	pageArgs := append([]interface{}{}, filterArgs...)
+	if params.cursor != nil {
+		sortArg, err := decodeSortValue(spec.kind, params.cursor.SortValue)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+			return
+		}
+		pageConditions = append(pageConditions, fmt.Sprintf("(%s, n.id) %s ($%d, $%d)", spec.expr, op, len(pageArgs)+1, len(pageArgs)+2))
+		pageArgs = append(pageArgs, sortArg, params.cursor.ID)
+	}
+
+	pageQuery := fmt.Sprintf(`
+		SELECT n.id, n.project_id, n.task_id, n.content, n.created_at, %s AS rank, %s AS sort_key
+		FROM notes n
+		WHERE %s
+		ORDER BY %s %s, n.id %s
+		LIMIT $%d`, rankExpr, spec.expr, strings.Join(pageConditions, " AND "), spec.expr, direction, direction, len(pageArgs)+1)
+	pageArgs = append(pageArgs, params.limit+1)
+
+	rows, err := pm.db.Query(pageQuery, pageArgs...)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	defer rows.Close()
 
-	var notes []Note = make([]Note, 0) // Initialize empty slice instead of nil
+	type scannedNote struct {
+		note    Note
+		rank    float64
+		sortKey interface{}
+	}
+
+	var scanned []scannedNote
 	for rows.Next() {
 		var note Note
-		err := rows.Scan(&note.ID, &note.ProjectID, &note.TaskID, &note.Content, &note.CreatedAt)
+		var rank float64
+		var sortKey interface{}
+		err := rows.Scan(&note.ID, &note.ProjectID, &note.TaskID, &note.Content, &note.CreatedAt, &rank, &sortKey)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
-		notes = append(notes, note)
+		scanned = append(scanned, scannedNote{note: note, rank: rank, sortKey: sortKey})
+	}
+
+	hasMore := len(scanned) > params.limit
+	if hasMore {
+		scanned = scanned[:params.limit]
+	}
+
+	var nextCursor string
+	if hasMore && len(scanned) > 0 {
+		last := scanned[len(scanned)-1]
+		nextCursor = encodeCursor(listCursor{SortField: sortField, SortValue: encodeSortValue(last.sortKey), ID: last.note.ID})
+	}
+
+	notes := make([]Note, 0, len(scanned))
+	for _, s := range scanned {
+		notes = append(notes, s.note)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(notes)
+	json.NewEncoder(w).Encode(listResponse{Items: notes, NextCursor: nextCursor, Total: total})
 }
 
 // CreateNote creates a new note
 func (pm *ProjectManager) CreateNote(w http.ResponseWriter, r *http.Request) {
 	var note Note
 	if err := json.NewDecoder(r.Body).Decode(&note); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
 	if note.Content == "" {
-		http.Error(w, "Content is required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Content is required")
 		return
 	}
 
 	// If task_id is provided, get the project_id from the task
 	if note.TaskID != nil {
-		err := pm.db.QueryRow("SELECT project_id FROM tasks WHERE id = $1 AND deleted_at IS NULL", *note.TaskID).Scan(&note.ProjectID)
+		projectID, err := pm.store.Notes().ProjectIDForTask(r.Context(), *note.TaskID)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				http.Error(w, "Task not found", http.StatusNotFound)
+			if err == storage.ErrNotFound {
+				writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
 			} else {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			}
 			return
 		}
+		note.ProjectID = projectID
 	} else if note.ProjectID == 0 {
-		http.Error(w, "Either task_id or project_id is required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Either task_id or project_id is required")
 		return
 	}
 
-	err := pm.db.QueryRow(
-		"INSERT INTO notes (project_id, task_id, content) VALUES ($1, $2, $3) RETURNING id, created_at",
-		note.ProjectID, note.TaskID, note.Content,
-	).Scan(&note.ID, &note.CreatedAt)
+	var created storage.Note
+	err := pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		var err error
+		created, err = repos.Notes.Create(r.Context(), storage.Note{
+			ProjectID: note.ProjectID,
+			TaskID:    note.TaskID,
+			Content:   note.Content,
+		})
+		if err != nil {
+			return err
+		}
 
+		// Notes don't have their own activity feed; fold the event into
+		// whichever parent (task or project) GetTaskActivity/GetProjectActivity
+		// would otherwise show.
+		objectType, objectID := "project", created.ProjectID
+		if created.TaskID != nil {
+			objectType, objectID = "task", *created.TaskID
+		}
+		return recordActivity(r.Context(), repos.Activity, r, created.ProjectID, objectType, objectID,
+			"note_created", fmt.Sprintf("Added a note: %q", truncate(created.Content, 80)), nil, created.Content)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
+	note = toAPINote(created)
+
+	pm.publish("note.created", note.ProjectID, note)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
@@ -721,28 +2034,118 @@ func (pm *ProjectManager) DeleteNote(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	noteID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, "Invalid note ID", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid note ID")
 		return
 	}
 
-	result, err := pm.db.Exec("DELETE FROM notes WHERE id = $1", noteID)
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		n, err := repos.Notes.Get(r.Context(), noteID)
+		if err != nil {
+			return err
+		}
+		if err := repos.Notes.Delete(r.Context(), noteID); err != nil {
+			return err
+		}
+
+		objectType, objectID := "project", n.ProjectID
+		if n.TaskID != nil {
+			objectType, objectID = "task", *n.TaskID
+		}
+		return recordActivity(r.Context(), repos.Activity, r, n.ProjectID, objectType, objectID,
+			"note_deleted", fmt.Sprintf("Deleted a note: %q", truncate(n.Content, 80)), n.Content, nil)
+	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Note not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
 		return
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// activitySortSpecs whitelists the columns GetProjectActivity/GetTaskActivity
+// may order by via ?sort=; like noteSortSpecs, created_at is the only one.
+var activitySortSpecs = map[string]sortSpec{
+	"created_at": {expr: "created_at", kind: "time"},
+}
+
+// writeActivityFeed is shared by GetProjectActivity/GetTaskActivity, which
+// differ only in which ActivityRepo method and path param they read from.
+func (pm *ProjectManager) writeActivityFeed(w http.ResponseWriter, r *http.Request, list func(ctx context.Context, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error)) {
+	params, err := parseListParams(r, activitySortSpecs, "created_at")
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
-	if rowsAffected == 0 {
-		http.Error(w, "Note not found", http.StatusNotFound)
+	var before *time.Time
+	var beforeID int
+	if params.cursor != nil {
+		t, err := decodeSortValue(activitySortSpecs[params.sortField].kind, params.cursor.SortValue)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+			return
+		}
+		tv := t.(time.Time)
+		before = &tv
+		beforeID = params.cursor.ID
+	}
+
+	events, total, err := list(r.Context(), params.limit+1, before, beforeID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	hasMore := len(events) > params.limit
+	if hasMore {
+		events = events[:params.limit]
+	}
+
+	var nextCursor string
+	if hasMore && len(events) > 0 {
+		last := events[len(events)-1]
+		nextCursor = encodeCursor(listCursor{SortField: params.sortField, SortValue: encodeSortValue(last.CreatedAt), ID: last.ID})
+	}
+
+	items := make([]ActivityEvent, 0, len(events))
+	for _, e := range events {
+		items = append(items, toAPIActivityEvent(e))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(listResponse{Items: items, NextCursor: nextCursor, Total: total})
+}
+
+// GetProjectActivity returns the cursor-paginated audit log for a project.
+func (pm *ProjectManager) GetProjectActivity(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
+		return
+	}
+
+	pm.writeActivityFeed(w, r, func(ctx context.Context, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error) {
+		return pm.store.Activity().ListForProject(ctx, projectID, limit, before, beforeID)
+	})
+}
+
+// GetTaskActivity returns the cursor-paginated audit log for a task.
+func (pm *ProjectManager) GetTaskActivity(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	pm.writeActivityFeed(w, r, func(ctx context.Context, limit int, before *time.Time, beforeID int) ([]storage.ActivityEvent, int, error) {
+		return pm.store.Activity().ListForTask(ctx, taskID, limit, before, beforeID)
+	})
 }
 
 func (pm *ProjectManager) GetDashboard(w http.ResponseWriter, r *http.Request) {
@@ -752,14 +2155,14 @@ func (pm *ProjectManager) GetDashboard(w http.ResponseWriter, r *http.Request) {
 	// Get total projects
 	err := pm.db.QueryRow("SELECT COUNT(*) FROM projects WHERE deleted_at IS NULL").Scan(&dashboard.TotalProjects)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 
 	// Get tasks by status
 	rows, err := pm.db.Query("SELECT status, COUNT(*) FROM tasks WHERE deleted_at IS NULL GROUP BY status")
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	defer rows.Close()
@@ -768,23 +2171,25 @@ func (pm *ProjectManager) GetDashboard(w http.ResponseWriter, r *http.Request) {
 		var status string
 		var count int
 		if err := rows.Scan(&status, &count); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
 		dashboard.TasksByStatus[status] = count
 	}
 
-	// Get recent tasks
+	// Get recent tasks. Paused tasks are deliberately on hold, so they're
+	// excluded from this active-work view the same way they're excluded from
+	// GetTasks by default.
 	taskRows, err := pm.db.Query(`
 		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.created_at, t.updated_at, p.name
-		FROM tasks t 
-		JOIN projects p ON t.project_id = p.id 
-		WHERE t.deleted_at IS NULL AND p.deleted_at IS NULL
-		ORDER BY t.updated_at DESC 
+		FROM tasks t
+		JOIN projects p ON t.project_id = p.id
+		WHERE t.deleted_at IS NULL AND p.deleted_at IS NULL AND t.status != 'paused'
+		ORDER BY t.updated_at DESC
 		LIMIT 10
 	`)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	defer taskRows.Close()
@@ -793,7 +2198,7 @@ func (pm *ProjectManager) GetDashboard(w http.ResponseWriter, r *http.Request) {
 		var t Task
 		err := taskRows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &t.CreatedAt, &t.UpdatedAt, &t.ProjectName)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
 		dashboard.RecentTasks = append(dashboard.RecentTasks, t)
@@ -817,6 +2222,74 @@ func (pm *ProjectManager) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// GetEvents upgrades to a text/event-stream connection and pushes JSON
+// events for project/task/note mutations as they happen. ?project_id=
+// filters the stream to one project; omitted or 0 streams every project. A
+// client reconnecting with a Last-Event-ID header first replays any
+// buffered events it missed before joining the live stream.
+func (pm *ProjectManager) GetEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, "streaming not supported")
+		return
+	}
+
+	projectID := 0
+	if projectIDStr := r.URL.Query().Get("project_id"); projectIDStr != "" {
+		parsed, err := strconv.Atoi(projectIDStr)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, "project_id must be an integer")
+			return
+		}
+		projectID = parsed
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// Subscribe before replaying: if we replayed first and subscribed after,
+	// any event published in between would be delivered to neither. Doing it
+	// in this order can instead replay an event that also arrives on ch, so
+	// the live loop below drops anything at or below the last replayed ID.
+	ch := pm.subscribe(projectID)
+	defer pm.unsubscribe(ch)
+
+	var lastReplayedID int64
+	if lastEventIDStr := r.Header.Get("Last-Event-ID"); lastEventIDStr != "" {
+		if lastEventID, err := strconv.ParseInt(lastEventIDStr, 10, 64); err == nil {
+			for _, evt := range pm.eventsSince(lastEventID, projectID) {
+				writeSSEEvent(w, evt)
+				lastReplayedID = evt.ID
+			}
+			flusher.Flush()
+		}
+	}
+
+	for {
+		select {
+		case evt := <-ch:
+			if evt.ID <= lastReplayedID {
+				continue
+			}
+			writeSSEEvent(w, evt)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeSSEEvent writes evt to w in text/event-stream wire format.
+func writeSSEEvent(w http.ResponseWriter, evt Event) {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", evt.ID, evt.Type, payload)
+}
+
 // Version information structure
 type VersionInfo struct {
 	Version     string `json:"version"`
@@ -952,7 +2425,7 @@ func (pm *ProjectManager) initDatabase() {
 
 	// Add blocked columns to tasks table if they don't exist
 	alterTasksTable := `
-	ALTER TABLE tasks 
+	ALTER TABLE tasks
 	ADD COLUMN IF NOT EXISTS is_blocked BOOLEAN DEFAULT FALSE,
 	ADD COLUMN IF NOT EXISTS blocked_reason TEXT NULL`
 
@@ -960,6 +2433,206 @@ func (pm *ProjectManager) initDatabase() {
 		log.Fatal("Failed to add blocked columns to tasks table:", err)
 	}
 
+	// Create labels table
+	labelsTable := `
+	CREATE TABLE IF NOT EXISTS labels (
+		id SERIAL PRIMARY KEY,
+		key VARCHAR(255) UNIQUE NOT NULL,
+		color VARCHAR(7) NOT NULL DEFAULT '#6B7280',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	// Create task_labels join table
+	taskLabelsTable := `
+	CREATE TABLE IF NOT EXISTS task_labels (
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		label_id INTEGER NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+		PRIMARY KEY (task_id, label_id)
+	)`
+
+	// Create project_labels join table
+	projectLabelsTable := `
+	CREATE TABLE IF NOT EXISTS project_labels (
+		project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+		label_id INTEGER NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+		PRIMARY KEY (project_id, label_id)
+	)`
+
+	if _, err := pm.db.Exec(labelsTable); err != nil {
+		log.Fatal("Failed to create labels table:", err)
+	}
+
+	if _, err := pm.db.Exec(taskLabelsTable); err != nil {
+		log.Fatal("Failed to create task_labels table:", err)
+	}
+
+	if _, err := pm.db.Exec(projectLabelsTable); err != nil {
+		log.Fatal("Failed to create project_labels table:", err)
+	}
+
+	// Add story_points column to tasks table if it doesn't exist
+	alterTasksStoryPoints := `
+	ALTER TABLE tasks
+	ADD COLUMN IF NOT EXISTS story_points INTEGER NOT NULL DEFAULT 0`
+
+	if _, err := pm.db.Exec(alterTasksStoryPoints); err != nil {
+		log.Fatal("Failed to add story_points column to tasks table:", err)
+	}
+
+	// Add retention_seconds column to tasks table if it doesn't exist. 0
+	// means a task never expires; defaultRetentionSeconds() is only applied
+	// by CreateTask when a request doesn't specify one.
+	alterTasksRetention := `
+	ALTER TABLE tasks
+	ADD COLUMN IF NOT EXISTS retention_seconds INTEGER NOT NULL DEFAULT 0`
+
+	if _, err := pm.db.Exec(alterTasksRetention); err != nil {
+		log.Fatal("Failed to add retention_seconds column to tasks table:", err)
+	}
+
+	// Add pause columns to tasks table if they don't exist. resume_status
+	// holds the status to restore on Resume/the auto-resume sweeper; NULL
+	// paused_until means the task stays paused until explicitly resumed.
+	alterTasksPause := `
+	ALTER TABLE tasks
+	ADD COLUMN IF NOT EXISTS paused_until TIMESTAMP NULL,
+	ADD COLUMN IF NOT EXISTS resume_status VARCHAR(50) NULL,
+	ADD COLUMN IF NOT EXISTS pause_reason TEXT NULL`
+
+	if _, err := pm.db.Exec(alterTasksPause); err != nil {
+		log.Fatal("Failed to add pause columns to tasks table:", err)
+	}
+
+	// Create sprints table
+	sprintsTable := `
+	CREATE TABLE IF NOT EXISTS sprints (
+		id SERIAL PRIMARY KEY,
+		project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+		name VARCHAR(255) NOT NULL,
+		start_date DATE NOT NULL,
+		end_date DATE NOT NULL,
+		goal TEXT,
+		status VARCHAR(50) NOT NULL DEFAULT 'planned',
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	// Create sprint_tasks join table
+	sprintTasksTable := `
+	CREATE TABLE IF NOT EXISTS sprint_tasks (
+		sprint_id INTEGER NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		PRIMARY KEY (sprint_id, task_id)
+	)`
+
+	// Create task_status_history table, populated on every status-affecting
+	// mutation so burndown charts can reconstruct counts at any past date
+	taskStatusHistoryTable := `
+	CREATE TABLE IF NOT EXISTS task_status_history (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		status VARCHAR(50) NOT NULL,
+		changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	if _, err := pm.db.Exec(sprintsTable); err != nil {
+		log.Fatal("Failed to create sprints table:", err)
+	}
+
+	if _, err := pm.db.Exec(sprintTasksTable); err != nil {
+		log.Fatal("Failed to create sprint_tasks table:", err)
+	}
+
+	if _, err := pm.db.Exec(taskStatusHistoryTable); err != nil {
+		log.Fatal("Failed to create task_status_history table:", err)
+	}
+
+	// Full-text search: generated tsvector columns plus GIN indexes for
+	// tasks/projects/notes. Postgres keeps a STORED generated column in
+	// sync on every write, so no triggers are needed to maintain it.
+	tasksTsvColumn := `
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS tsv tsvector
+	GENERATED ALWAYS AS (to_tsvector('english', coalesce(title, '') || ' ' || coalesce(description, ''))) STORED`
+
+	projectsTsvColumn := `
+	ALTER TABLE projects ADD COLUMN IF NOT EXISTS tsv tsvector
+	GENERATED ALWAYS AS (to_tsvector('english', coalesce(name, '') || ' ' || coalesce(description, ''))) STORED`
+
+	notesTsvColumn := `
+	ALTER TABLE notes ADD COLUMN IF NOT EXISTS tsv tsvector
+	GENERATED ALWAYS AS (to_tsvector('english', coalesce(content, ''))) STORED`
+
+	if _, err := pm.db.Exec(tasksTsvColumn); err != nil {
+		log.Fatal("Failed to add tsv column to tasks table:", err)
+	}
+
+	if _, err := pm.db.Exec(projectsTsvColumn); err != nil {
+		log.Fatal("Failed to add tsv column to projects table:", err)
+	}
+
+	if _, err := pm.db.Exec(notesTsvColumn); err != nil {
+		log.Fatal("Failed to add tsv column to notes table:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_tasks_tsv ON tasks USING GIN (tsv)"); err != nil {
+		log.Fatal("Failed to create tasks tsv index:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_projects_tsv ON projects USING GIN (tsv)"); err != nil {
+		log.Fatal("Failed to create projects tsv index:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_notes_tsv ON notes USING GIN (tsv)"); err != nil {
+		log.Fatal("Failed to create notes tsv index:", err)
+	}
+
+	// Create activity_events table: an append-only audit log of every
+	// mutation, written in the same transaction as the mutation itself.
+	activityEventsTable := `
+	CREATE TABLE IF NOT EXISTS activity_events (
+		id SERIAL PRIMARY KEY,
+		actor VARCHAR(255) NOT NULL DEFAULT 'system',
+		project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+		object_type VARCHAR(50) NOT NULL,
+		object_id INTEGER NOT NULL,
+		action VARCHAR(50) NOT NULL,
+		description TEXT,
+		diff JSONB,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+
+	if _, err := pm.db.Exec(activityEventsTable); err != nil {
+		log.Fatal("Failed to create activity_events table:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_activity_events_project ON activity_events (project_id, created_at DESC)"); err != nil {
+		log.Fatal("Failed to create activity_events project index:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_activity_events_object ON activity_events (object_type, object_id, created_at DESC)"); err != nil {
+		log.Fatal("Failed to create activity_events object index:", err)
+	}
+
+	// Create task_dependencies table: a "task depends on task" edge. A task
+	// isn't considered unblocked until every task it depends on reaches "done".
+	taskDependenciesTable := `
+	CREATE TABLE IF NOT EXISTS task_dependencies (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		depends_on_task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (task_id, depends_on_task_id),
+		CHECK (task_id != depends_on_task_id)
+	)`
+
+	if _, err := pm.db.Exec(taskDependenciesTable); err != nil {
+		log.Fatal("Failed to create task_dependencies table:", err)
+	}
+
+	if _, err := pm.db.Exec("CREATE INDEX IF NOT EXISTS idx_task_dependencies_depends_on ON task_dependencies (depends_on_task_id)"); err != nil {
+		log.Fatal("Failed to create task_dependencies index:", err)
+	}
+
 	// Seed default status values
 	pm.seedStatusValues()
 	pm.seedPriorityValues()
@@ -973,7 +2646,8 @@ func (pm *ProjectManager) seedStatusValues() {
 		{Key: "in_progress", Label: "In Progress", Description: "Tasks currently being worked on", Color: "#3B82F6", SortOrder: 2, IsActive: true},
 		{Key: "review", Label: "Review", Description: "Tasks waiting for review or approval", Color: "#F59E0B", SortOrder: 3, IsActive: true},
 		{Key: "done", Label: "Done", Description: "Completed tasks", Color: "#10B981", SortOrder: 4, IsActive: true},
-		{Key: "deleted", Label: "Deleted", Description: "Tasks that have been deleted", Color: "#EF4444", SortOrder: 5, IsActive: false}, // Hidden from main board
+		{Key: "paused", Label: "Paused", Description: "Tasks deliberately put on hold", Color: "#9333EA", SortOrder: 5, IsActive: true},
+		{Key: "deleted", Label: "Deleted", Description: "Tasks that have been deleted", Color: "#EF4444", SortOrder: 6, IsActive: false}, // Hidden from main board
 	}
 
 	for _, status := range statusValues {
@@ -1017,7 +2691,7 @@ func (pm *ProjectManager) GetStatusValues(w http.ResponseWriter, r *http.Request
 		ORDER BY sort_order`)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	defer rows.Close()
@@ -1027,7 +2701,7 @@ func (pm *ProjectManager) GetStatusValues(w http.ResponseWriter, r *http.Request
 		var s StatusValue
 		err := rows.Scan(&s.ID, &s.Key, &s.Label, &s.Description, &s.Color, &s.SortOrder, &s.IsActive, &s.CreatedAt)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
 		statusValues = append(statusValues, s)
@@ -1045,7 +2719,7 @@ func (pm *ProjectManager) GetPriorityValues(w http.ResponseWriter, r *http.Reque
 		ORDER BY level`)
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	defer rows.Close()
@@ -1055,7 +2729,7 @@ func (pm *ProjectManager) GetPriorityValues(w http.ResponseWriter, r *http.Reque
 		var p PriorityValue
 		err := rows.Scan(&p.ID, &p.Key, &p.Label, &p.Description, &p.Color, &p.Icon, &p.Level, &p.IsActive, &p.CreatedAt)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 			return
 		}
 		priorityValues = append(priorityValues, p)
@@ -1072,31 +2746,58 @@ func main() {
 	// Initialize database tables and seed data
 	pm.initDatabase()
 
+	go pm.runRetentionSweeper()
+	go pm.runPauseSweeper()
+
 	r := mux.NewRouter()
+	r.Use(requestIDMiddleware)
 
 	// API routes
 	api := r.PathPrefix("/api").Subrouter()
 	api.HandleFunc("/health", pm.HealthCheck).Methods("GET")
 	api.HandleFunc("/dashboard", pm.GetDashboard).Methods("GET")
+	api.HandleFunc("/events", pm.GetEvents).Methods("GET")
 	api.HandleFunc("/projects", pm.GetProjects).Methods("GET")
 	api.HandleFunc("/projects", pm.CreateProject).Methods("POST")
 	api.HandleFunc("/projects/{id:[0-9]+}", pm.GetProject).Methods("GET")
 	api.HandleFunc("/projects/{id:[0-9]+}", pm.UpdateProject).Methods("PUT")
 	api.HandleFunc("/projects/{id:[0-9]+}", pm.DeleteProject).Methods("DELETE")
+	api.HandleFunc("/projects/{id:[0-9]+}/activity", pm.GetProjectActivity).Methods("GET")
+	api.HandleFunc("/projects/{id:[0-9]+}/graph", pm.GetProjectGraph).Methods("GET")
 	api.HandleFunc("/tasks", pm.GetTasks).Methods("GET")
 	api.HandleFunc("/tasks", pm.CreateTask).Methods("POST")
+	api.HandleFunc("/tasks/bulk", pm.BulkUpdateTasks).Methods("POST")
 	api.HandleFunc("/tasks/deleted", pm.GetDeletedTasks).Methods("GET")
+	api.HandleFunc("/tasks/expiring", pm.GetExpiringTasks).Methods("GET")
 	api.HandleFunc("/tasks/{id:[0-9]+}", pm.GetTask).Methods("GET")
 	api.HandleFunc("/tasks/{id:[0-9]+}", pm.UpdateTask).Methods("PUT")
 	api.HandleFunc("/tasks/{id:[0-9]+}", pm.DeleteTask).Methods("DELETE")
 	api.HandleFunc("/tasks/{id:[0-9]+}/recover", pm.RecoverTask).Methods("POST")
 	api.HandleFunc("/tasks/{id:[0-9]+}/block", pm.BlockTask).Methods("POST")
 	api.HandleFunc("/tasks/{id:[0-9]+}/unblock", pm.UnblockTask).Methods("POST")
+	api.HandleFunc("/tasks/{id:[0-9]+}/pause", pm.PauseTask).Methods("POST")
+	api.HandleFunc("/tasks/{id:[0-9]+}/resume", pm.ResumeTask).Methods("POST")
+	api.HandleFunc("/tasks/{id:[0-9]+}/activity", pm.GetTaskActivity).Methods("GET")
+	api.HandleFunc("/tasks/{id:[0-9]+}/dependencies", pm.GetTaskDependencies).Methods("GET")
+	api.HandleFunc("/tasks/{id:[0-9]+}/dependencies", pm.CreateTaskDependency).Methods("POST")
+	api.HandleFunc("/tasks/{id:[0-9]+}/dependencies/{depID:[0-9]+}", pm.DeleteTaskDependency).Methods("DELETE")
 	api.HandleFunc("/status-values", pm.GetStatusValues).Methods("GET")
 	api.HandleFunc("/priority-values", pm.GetPriorityValues).Methods("GET")
 	api.HandleFunc("/notes", pm.GetNotes).Methods("GET")
 	api.HandleFunc("/notes", pm.CreateNote).Methods("POST")
 	api.HandleFunc("/notes/{id:[0-9]+}", pm.DeleteNote).Methods("DELETE")
+	api.HandleFunc("/labels", pm.GetLabels).Methods("GET")
+	api.HandleFunc("/labels", pm.CreateLabel).Methods("POST")
+	api.HandleFunc("/labels/assign", pm.AssignLabel).Methods("POST")
+	api.HandleFunc("/labels/remove", pm.RemoveLabel).Methods("POST")
+	api.HandleFunc("/sprints", pm.GetSprints).Methods("GET")
+	api.HandleFunc("/sprints", pm.CreateSprint).Methods("POST")
+	api.HandleFunc("/sprints/{id:[0-9]+}", pm.GetSprint).Methods("GET")
+	api.HandleFunc("/sprints/{id:[0-9]+}", pm.UpdateSprint).Methods("PUT")
+	api.HandleFunc("/sprints/{id:[0-9]+}", pm.DeleteSprint).Methods("DELETE")
+	api.HandleFunc("/sprints/{id:[0-9]+}/tasks", pm.AssignTaskToSprint).Methods("POST")
+	api.HandleFunc("/sprints/{id:[0-9]+}/tasks/{taskId:[0-9]+}", pm.RemoveTaskFromSprint).Methods("DELETE")
+	api.HandleFunc("/sprints/{id:[0-9]+}/burndown", pm.GetSprintBurndown).Methods("GET")
 	api.HandleFunc("/tasks-deleted", pm.GetDeletedTasks).Methods("GET")
 	api.HandleFunc("/tasks/{id:[0-9]+}/recover", pm.RecoverTask).Methods("POST")
 
@@ -1117,172 +2818,936 @@ func main() {
 	log.Fatal(http.ListenAndServe(":"+port, handler))
 }
 
-// getNotesForTask fetches all notes for a specific task
+// getNotesForTask fetches all notes for a specific task via the NoteRepo.
 func (pm *ProjectManager) getNotesForTask(taskID int) ([]Note, error) {
-	query := `
-		SELECT id, project_id, task_id, content, created_at 
-		FROM notes 
-		WHERE task_id = $1 
-		ORDER BY created_at DESC
-	`
-
-	rows, err := pm.db.Query(query, taskID)
+	notes, err := pm.store.Notes().ListForTask(context.Background(), taskID)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	apiNotes := make([]Note, 0, len(notes))
+	for _, n := range notes {
+		apiNotes = append(apiNotes, toAPINote(n))
+	}
+	return apiNotes, nil
+}
 
-	var notes []Note
-	for rows.Next() {
-		var note Note
-		err := rows.Scan(&note.ID, &note.ProjectID, &note.TaskID, &note.Content, &note.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-		notes = append(notes, note)
+// labelScope returns the scope prefix of a "scope/name" label key and
+// whether the key is scoped at all. Unscoped labels (no "/") don't
+// participate in exclusivity.
+func labelScope(key string) (string, bool) {
+	idx := strings.LastIndex(key, "/")
+	if idx < 0 {
+		return "", false
 	}
+	return key[:idx], true
+}
 
-	// Return empty slice instead of nil if no notes found
-	if notes == nil {
-		notes = []Note{}
+// getLabelsForTask fetches all labels assigned to a specific task via the
+// LabelRepo.
+func (pm *ProjectManager) getLabelsForTask(taskID int) ([]Label, error) {
+	labels, err := pm.store.Labels().ForTask(context.Background(), taskID)
+	if err != nil {
+		return nil, err
 	}
+	return toAPILabels(labels), nil
+}
 
-	return notes, nil
+// getLabelsForProject fetches all labels assigned to a specific project via
+// the LabelRepo.
+func (pm *ProjectManager) getLabelsForProject(projectID int) ([]Label, error) {
+	labels, err := pm.store.Labels().ForProject(context.Background(), projectID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPILabels(labels), nil
 }
 
-// GetDeletedTasks retrieves all soft-deleted tasks
-func (pm *ProjectManager) GetDeletedTasks(w http.ResponseWriter, r *http.Request) {
-	projectID := r.URL.Query().Get("project_id")
+// GetLabels lists every label known to the system
+func (pm *ProjectManager) GetLabels(w http.ResponseWriter, r *http.Request) {
+	stored, err := pm.store.Labels().List(r.Context())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
 
-	query := `
-		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.is_blocked, t.blocked_reason, t.created_at, t.updated_at, t.deleted_at, t.deletion_reason, p.name
-		FROM tasks t 
-		JOIN projects p ON t.project_id = p.id 
-		WHERE t.deleted_at IS NOT NULL AND p.deleted_at IS NULL
-	`
-	args := []interface{}{}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(toAPILabels(stored))
+}
 
-	if projectID != "" {
-		query += " AND t.project_id = $1"
-		args = append(args, projectID)
+// CreateLabel creates a new label. Labels named "scope/name" are part of
+// the "scope" exclusivity group enforced by AssignLabel.
+func (pm *ProjectManager) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	var l Label
+	if err := json.NewDecoder(r.Body).Decode(&l); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
 	}
 
-	query += " ORDER BY t.deleted_at DESC"
-
-	rows, err := pm.db.Query(query, args...)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if l.Key == "" {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Label key is required")
 		return
 	}
-	defer rows.Close()
 
-	var tasks []Task
-	for rows.Next() {
-		var t Task
-		var isBlocked sql.NullBool
-		err := rows.Scan(&t.ID, &t.ProjectID, &t.Title, &t.Description, &t.Status, &t.Priority, &isBlocked, &t.BlockedReason, &t.CreatedAt, &t.UpdatedAt, &t.DeletedAt, &t.DeletionReason, &t.ProjectName)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
+	if l.Color == "" {
+		l.Color = "#6B7280"
+	}
 
-		// Handle nullable boolean - default to false if null
-		if isBlocked.Valid {
-			t.IsBlocked = isBlocked.Bool
+	created, err := pm.store.Labels().Create(r.Context(), l.Key, l.Color)
+	if err != nil {
+		if err == storage.ErrConflict {
+			writeJSONError(w, http.StatusConflict, ErrConflict, "Label already exists")
 		} else {
-			t.IsBlocked = false
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		}
+		return
+	}
 
-		// Fetch notes for this task
-		notes, err := pm.getNotesForTask(t.ID)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		t.Notes = notes
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toAPILabel(created))
+}
 
-		tasks = append(tasks, t)
+// labelAssignmentRequest identifies a label (by id, or by key if it should
+// be created on demand) and the single task or project it applies to.
+type labelAssignmentRequest struct {
+	LabelID   *int    `json:"label_id"`
+	Key       *string `json:"key"`
+	TaskID    *int    `json:"task_id"`
+	ProjectID *int    `json:"project_id"`
+}
+
+// resolveLabelID returns the id and key of the label named in req, creating
+// it from req.Key if no label_id was given.
+func resolveLabelID(ctx context.Context, labels storage.LabelRepo, req labelAssignmentRequest) (int, string, error) {
+	if req.LabelID != nil {
+		l, err := labels.Get(ctx, *req.LabelID)
+		return *req.LabelID, l.Key, err
 	}
 
-	// Return empty array instead of null if no tasks found
-	if tasks == nil {
-		tasks = []Task{}
+	if req.Key == nil || *req.Key == "" {
+		return 0, "", fmt.Errorf("label_id or key is required")
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(tasks)
+	id, err := labels.ResolveOrCreateID(ctx, *req.Key)
+	return id, *req.Key, err
 }
 
-// RecoverTask restores a soft-deleted task
-func (pm *ProjectManager) RecoverTask(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	taskID, err := strconv.Atoi(vars["id"])
-	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+// AssignLabel assigns a label to exactly one task or project. If the label
+// is scoped ("scope/name"), any other labels already assigned to that task
+// or project in the same scope are atomically removed first, so a given
+// task/project can never hold two labels from the same scope.
+func (pm *ProjectManager) AssignLabel(w http.ResponseWriter, r *http.Request) {
+	var req labelAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
 		return
 	}
 
-	var recoverRequest struct {
-		Status string `json:"status"`
+	if (req.TaskID == nil) == (req.ProjectID == nil) {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Exactly one of task_id or project_id is required")
+		return
 	}
-	if err := json.NewDecoder(r.Body).Decode(&recoverRequest); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+
+	var resolveErr error
+	err := pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		labelID, key, err := resolveLabelID(r.Context(), repos.Labels, req)
+		if err != nil {
+			resolveErr = err
+			return err
+		}
+
+		if scope, scoped := labelScope(key); scoped {
+			if req.TaskID != nil {
+				err = repos.Labels.RemoveTaskLabelsByScope(r.Context(), *req.TaskID, scope)
+			} else {
+				err = repos.Labels.RemoveProjectLabelsByScope(r.Context(), *req.ProjectID, scope)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if req.TaskID != nil {
+			return repos.Labels.AddTaskLabel(r.Context(), *req.TaskID, labelID)
+		}
+		return repos.Labels.AddProjectLabel(r.Context(), *req.ProjectID, labelID)
+	})
+	if err != nil {
+		if resolveErr != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, resolveErr.Error())
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
 		return
 	}
 
-	// Default to "todo" if no status provided
-	if recoverRequest.Status == "" {
-		recoverRequest.Status = "todo"
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveLabel removes a label assignment from a task or project. It does
+// not delete the label itself.
+func (pm *ProjectManager) RemoveLabel(w http.ResponseWriter, r *http.Request) {
+	var req labelAssignmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
 	}
 
-	// Recover the task by clearing deletion fields and setting new status
-	result, err := pm.db.Exec(`
-		UPDATE tasks 
-		SET deleted_at = NULL, deletion_reason = NULL, status = $1, updated_at = CURRENT_TIMESTAMP 
-		WHERE id = $2 AND deleted_at IS NOT NULL`,
-		recoverRequest.Status, taskID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if req.LabelID == nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "label_id is required")
 		return
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	if (req.TaskID == nil) == (req.ProjectID == nil) {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Exactly one of task_id or project_id is required")
+		return
+	}
+
+	var removed bool
+	var err error
+	if req.TaskID != nil {
+		removed, err = pm.store.Labels().RemoveTaskLabel(r.Context(), *req.TaskID, *req.LabelID)
+	} else {
+		removed, err = pm.store.Labels().RemoveProjectLabel(r.Context(), *req.ProjectID, *req.LabelID)
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 
-	if rowsAffected == 0 {
-		http.Error(w, "Deleted task not found", http.StatusNotFound)
+	if !removed {
+		writeJSONError(w, http.StatusNotFound, ErrNotFound, "Label assignment not found")
 		return
 	}
 
-	// Return the recovered task
-	var task Task
-	var isBlocked sql.NullBool
-	err = pm.db.QueryRow(`
-		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.is_blocked, t.blocked_reason, t.created_at, t.updated_at, p.name
-		FROM tasks t 
-		JOIN projects p ON t.project_id = p.id 
-		WHERE t.id = $1 AND t.deleted_at IS NULL AND p.deleted_at IS NULL`, taskID).
-		Scan(&task.ID, &task.ProjectID, &task.Title, &task.Description, &task.Status, &task.Priority, &isBlocked, &task.BlockedReason, &task.CreatedAt, &task.UpdatedAt, &task.ProjectName)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// syncBlockedFromDependencies auto-derives taskID's is_blocked flag from its
+// unresolved dependencies: blocked if any upstream task isn't "done" yet,
+// unblocked otherwise. It runs inside the caller's Store.Tx alongside
+// whatever dependency or status change triggered it.
+func syncBlockedFromDependencies(ctx context.Context, repos storage.Repos, taskID int) error {
+	unresolved, err := repos.Dependencies.UnresolvedCount(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if unresolved > 0 {
+		_, err = repos.Tasks.SetBlocked(ctx, taskID, fmt.Sprintf("Blocked by %d unresolved dependencies", unresolved))
+		return err
+	}
+	_, err = repos.Tasks.ClearBlocked(ctx, taskID)
+	return err
+}
 
+// CreateTaskDependency records that the task in the URL depends on another
+// task, rejecting the request if it would close a dependency cycle.
+func (pm *ProjectManager) CreateTaskDependency(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
 		return
 	}
 
-	// Handle nullable boolean - default to false if null
-	if isBlocked.Valid {
-		task.IsBlocked = isBlocked.Bool
-	} else {
-		task.IsBlocked = false
+	var req struct {
+		DependsOnTaskID int `json:"depends_on_task_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+	if req.DependsOnTaskID == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "depends_on_task_id is required")
+		return
+	}
+	if req.DependsOnTaskID == taskID {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "A task cannot depend on itself")
+		return
+	}
+
+	var created storage.TaskDependency
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		task, err := repos.Tasks.Get(r.Context(), taskID)
+		if err != nil {
+			return err
+		}
+
+		wouldCycle, err := repos.Dependencies.Reaches(r.Context(), req.DependsOnTaskID, taskID)
+		if err != nil {
+			return err
+		}
+		if wouldCycle {
+			return errCyclicDependency
+		}
+
+		created, err = repos.Dependencies.Create(r.Context(), taskID, req.DependsOnTaskID)
+		if err != nil {
+			return err
+		}
+		if err := syncBlockedFromDependencies(r.Context(), repos, taskID); err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, task.ProjectID, "task", taskID,
+			"dependency_added", fmt.Sprintf("Added dependency on task %d", req.DependsOnTaskID), nil, created)
+	})
+	if err != nil {
+		switch {
+		case err == errCyclicDependency:
+			writeJSONError(w, http.StatusConflict, ErrConflict, "This dependency would create a cycle")
+		case err == storage.ErrNotFound:
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task not found")
+		case strings.Contains(err.Error(), "duplicate key"):
+			writeJSONError(w, http.StatusConflict, ErrConflict, "This dependency already exists")
+		default:
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(toAPITaskDependency(created))
+}
+
+// errCyclicDependency is returned from inside CreateTaskDependency's Store.Tx
+// when adding the requested edge would close a dependency cycle.
+var errCyclicDependency = fmt.Errorf("cyclic dependency")
+
+// DeleteTaskDependency removes a single dependency edge from a task.
+func (pm *ProjectManager) DeleteTaskDependency(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+	depID, err := strconv.Atoi(vars["depID"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid dependency ID")
+		return
+	}
+
+	err = pm.store.Tx(r.Context(), func(repos storage.Repos) error {
+		task, err := repos.Tasks.Get(r.Context(), taskID)
+		if err != nil {
+			return err
+		}
+
+		removed, err := repos.Dependencies.Delete(r.Context(), taskID, depID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return storage.ErrNotFound
+		}
+		if err := syncBlockedFromDependencies(r.Context(), repos, taskID); err != nil {
+			return err
+		}
+		return recordActivity(r.Context(), repos.Activity, r, task.ProjectID, "task", taskID,
+			"dependency_removed", fmt.Sprintf("Removed dependency %d", depID), nil, nil)
+	})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Dependency not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTaskDependencies returns a task's upstream (depends on) and downstream
+// (depends on it) tasks.
+func (pm *ProjectManager) GetTaskDependencies(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	upstream, err := pm.store.Dependencies().Upstream(r.Context(), taskID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+	downstream, err := pm.store.Dependencies().Downstream(r.Context(), taskID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	resp := TaskDependencies{Upstream: make([]Task, 0, len(upstream)), Downstream: make([]Task, 0, len(downstream))}
+	for _, t := range upstream {
+		resp.Upstream = append(resp.Upstream, toAPITask(t))
+	}
+	for _, t := range downstream {
+		resp.Downstream = append(resp.Downstream, toAPITask(t))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// GetProjectGraph returns every task and dependency edge in a project, for a
+// client-side dependency graph view.
+func (pm *ProjectManager) GetProjectGraph(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
+		return
+	}
+
+	nodes, edges, err := pm.store.Dependencies().GraphForProject(r.Context(), projectID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	graph := ProjectGraph{Nodes: make([]GraphNode, 0, len(nodes)), Edges: make([]GraphEdge, 0, len(edges))}
+	for _, n := range nodes {
+		graph.Nodes = append(graph.Nodes, GraphNode{TaskID: n.TaskID, Title: n.Title, Status: n.Status})
+	}
+	for _, e := range edges {
+		graph.Edges = append(graph.Edges, GraphEdge{TaskID: e.TaskID, DependsOnTaskID: e.DependsOnTaskID})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(graph)
+}
+
+// GetSprints lists sprints, optionally filtered by project_id
+func (pm *ProjectManager) GetSprints(w http.ResponseWriter, r *http.Request) {
+	projectID := r.URL.Query().Get("project_id")
+
+	query := "SELECT id, project_id, name, start_date, end_date, goal, status, created_at, updated_at FROM sprints"
+	args := []interface{}{}
+	if projectID != "" {
+		query += " WHERE project_id = $1"
+		args = append(args, projectID)
+	}
+	query += " ORDER BY start_date DESC"
+
+	rows, err := pm.db.Query(query, args...)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	sprints := []Sprint{}
+	for rows.Next() {
+		var s Sprint
+		if err := rows.Scan(&s.ID, &s.ProjectID, &s.Name, &s.StartDate, &s.EndDate, &s.Goal, &s.Status, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		sprints = append(sprints, s)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(sprints)
+}
+
+// CreateSprint creates a new sprint for a project
+func (pm *ProjectManager) CreateSprint(w http.ResponseWriter, r *http.Request) {
+	var s Sprint
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	if s.ProjectID == 0 || s.Name == "" {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Project ID and name are required")
+		return
+	}
+
+	if s.Status == "" {
+		s.Status = "planned"
+	}
+
+	err := pm.db.QueryRow(
+		"INSERT INTO sprints (project_id, name, start_date, end_date, goal, status) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at, updated_at",
+		s.ProjectID, s.Name, s.StartDate, s.EndDate, s.Goal, s.Status,
+	).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(s)
+}
+
+// GetSprint fetches a single sprint by id
+func (pm *ProjectManager) GetSprint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	var s Sprint
+	err = pm.db.QueryRow("SELECT id, project_id, name, start_date, end_date, goal, status, created_at, updated_at FROM sprints WHERE id = $1", sprintID).
+		Scan(&s.ID, &s.ProjectID, &s.Name, &s.StartDate, &s.EndDate, &s.Goal, &s.Status, &s.CreatedAt, &s.UpdatedAt)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Sprint not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s)
+}
+
+// UpdateSprint updates a sprint's fields
+func (pm *ProjectManager) UpdateSprint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	var updates map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	setParts := []string{}
+	args := []interface{}{}
+	argCount := 1
+
+	for field, value := range updates {
+		switch field {
+		case "name", "start_date", "end_date", "goal", "status":
+			setParts = append(setParts, fmt.Sprintf("%s = $%d", field, argCount))
+			args = append(args, value)
+			argCount++
+		}
+	}
+
+	if len(setParts) == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "No valid fields to update")
+		return
+	}
+
+	setParts = append(setParts, "updated_at = CURRENT_TIMESTAMP")
+	query := fmt.Sprintf("UPDATE sprints SET %s WHERE id = $%d RETURNING id, project_id, name, start_date, end_date, goal, status, created_at, updated_at",
+		strings.Join(setParts, ", "), argCount)
+	args = append(args, sprintID)
+
+	var s Sprint
+	err = pm.db.QueryRow(query, args...).Scan(&s.ID, &s.ProjectID, &s.Name, &s.StartDate, &s.EndDate, &s.Goal, &s.Status, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Sprint not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s)
+}
+
+// DeleteSprint removes a sprint. Sprints are hard-deleted since they're a
+// planning artifact, not project data worth recovering.
+func (pm *ProjectManager) DeleteSprint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	result, err := pm.db.Exec("DELETE FROM sprints WHERE id = $1", sprintID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	if rowsAffected == 0 {
+		writeJSONError(w, http.StatusNotFound, ErrNotFound, "Sprint not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AssignTaskToSprint adds a task to a sprint's backlog
+func (pm *ProjectManager) AssignTaskToSprint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	var req struct {
+		TaskID int `json:"task_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	if req.TaskID == 0 {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "task_id is required")
+		return
+	}
+
+	_, err = pm.db.Exec("INSERT INTO sprint_tasks (sprint_id, task_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", sprintID, req.TaskID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveTaskFromSprint removes a task from a sprint's backlog
+func (pm *ProjectManager) RemoveTaskFromSprint(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	taskID, err := strconv.Atoi(vars["taskId"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	result, err := pm.db.Exec("DELETE FROM sprint_tasks WHERE sprint_id = $1 AND task_id = $2", sprintID, taskID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	if rowsAffected == 0 {
+		writeJSONError(w, http.StatusNotFound, ErrNotFound, "Task is not assigned to this sprint")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// burndownPoint is one day's worth of remaining-work data in a sprint
+// burndown series.
+type burndownPoint struct {
+	Date                    string `json:"date"`
+	RemainingTasks          int    `json:"remaining_tasks"`
+	RemainingPoints         int    `json:"remaining_points"`
+	RemainingWeightedPoints int    `json:"remaining_weighted_points"`
+}
+
+// taskStatusEvent is one row of a task's status history, used to
+// reconstruct its status as of any given day.
+type taskStatusEvent struct {
+	status    string
+	changedAt time.Time
+}
+
+// sprintBurndownClosedStatuses are the statuses that count as "done" for
+// burndown purposes. A "blocked" task is still open work.
+var sprintBurndownClosedStatuses = map[string]bool{
+	"done":    true,
+	"deleted": true,
+}
+
+// GetSprintBurndown returns a day-by-day series of remaining open tasks
+// (and their summed and priority-weighted story points) for a sprint, from
+// its start_date through the earlier of end_date and today, reconstructed
+// from task_status_history.
+func (pm *ProjectManager) GetSprintBurndown(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	sprintID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid sprint ID")
+		return
+	}
+
+	var sprint Sprint
+	err = pm.db.QueryRow("SELECT id, project_id, name, start_date, end_date, goal, status, created_at, updated_at FROM sprints WHERE id = $1", sprintID).
+		Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status, &sprint.CreatedAt, &sprint.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Sprint not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
+	}
+
+	// priorityLevel joins priority_values the same way taskSortSpecs does for
+	// ?sort=priority, so "weighted" points below means story points scaled
+	// by the task's configured priority level (urgent=4 ... low=1) rather
+	// than a second, unrelated notion of weight.
+	rows, err := pm.db.Query(`
+		SELECT t.id, t.created_at, t.status, t.story_points, COALESCE(pv.level, 1) AS priority_level
+		FROM tasks t
+		JOIN sprint_tasks st ON st.task_id = t.id
+		LEFT JOIN priority_values pv ON pv.key = t.priority
+		WHERE st.sprint_id = $1`, sprintID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	type sprintTask struct {
+		createdAt     time.Time
+		storyPoints   int
+		priorityLevel int
+		history       []taskStatusEvent
+	}
+
+	tasksByID := map[int]*sprintTask{}
+	taskIDs := []int{}
+	for rows.Next() {
+		var id int
+		var createdAt time.Time
+		var status string
+		var storyPoints int
+		var priorityLevel int
+		if err := rows.Scan(&id, &createdAt, &status, &storyPoints, &priorityLevel); err != nil {
+			rows.Close()
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		tasksByID[id] = &sprintTask{createdAt: createdAt, storyPoints: storyPoints, priorityLevel: priorityLevel}
+		taskIDs = append(taskIDs, id)
+	}
+	rows.Close()
+
+	if len(taskIDs) > 0 {
+		placeholders := make([]string, len(taskIDs))
+		historyArgs := make([]interface{}, len(taskIDs))
+		for i, id := range taskIDs {
+			placeholders[i] = fmt.Sprintf("$%d", i+1)
+			historyArgs[i] = id
+		}
+
+		historyQuery := fmt.Sprintf(
+			"SELECT task_id, status, changed_at FROM task_status_history WHERE task_id IN (%s) ORDER BY task_id, changed_at",
+			strings.Join(placeholders, ","))
+
+		historyRows, err := pm.db.Query(historyQuery, historyArgs...)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		for historyRows.Next() {
+			var taskID int
+			var event taskStatusEvent
+			if err := historyRows.Scan(&taskID, &event.status, &event.changedAt); err != nil {
+				historyRows.Close()
+				writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+				return
+			}
+			tasksByID[taskID].history = append(tasksByID[taskID].history, event)
+		}
+		historyRows.Close()
+	}
+
+	// chunk3-5 asked for a full sprints subsystem (sprints table, task join
+	// table, CRUD endpoints, task_status_history, windowed burndown), but
+	// chunk2-2 had already built substantially the same subsystem, including
+	// this handler, under the name sprint_tasks rather than chunk3-5's
+	// task_sprints - the same relationship, so a second join table would
+	// just be duplication. The one piece of chunk3-5's contract chunk2-2
+	// didn't cover is delivered here: remaining_weighted_points below, and
+	// the end-of-series clamp.
+	//
+	// The series runs through whichever comes first: the sprint's end_date,
+	// or today, so an in-progress sprint doesn't project remaining work into
+	// days that haven't happened yet.
+	lastDay := sprint.EndDate
+	if today := time.Now(); today.Before(lastDay) {
+		lastDay = today
+	}
+
+	series := []burndownPoint{}
+	for day := sprint.StartDate; !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		endOfDay := day.AddDate(0, 0, 1)
+		remainingTasks := 0
+		remainingPoints := 0
+		remainingWeightedPoints := 0
+
+		for _, id := range taskIDs {
+			t := tasksByID[id]
+			if t.createdAt.After(endOfDay) {
+				continue
+			}
+
+			status := "todo"
+			for _, event := range t.history {
+				if event.changedAt.Before(endOfDay) {
+					status = event.status
+				} else {
+					break
+				}
+			}
+
+			if !sprintBurndownClosedStatuses[status] {
+				remainingTasks++
+				remainingPoints += t.storyPoints
+				remainingWeightedPoints += t.storyPoints * t.priorityLevel
+			}
+		}
+
+		series = append(series, burndownPoint{
+			Date:                    day.Format("2006-01-02"),
+			RemainingTasks:          remainingTasks,
+			RemainingPoints:         remainingPoints,
+			RemainingWeightedPoints: remainingWeightedPoints,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Sprint Sprint          `json:"sprint"`
+		Series []burndownPoint `json:"series"`
+	}{Sprint: sprint, Series: series})
+}
+
+// GetDeletedTasks retrieves all soft-deleted tasks
+func (pm *ProjectManager) GetDeletedTasks(w http.ResponseWriter, r *http.Request) {
+	var projectID *int
+	if raw := r.URL.Query().Get("project_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid project ID")
+			return
+		}
+		projectID = &id
+	}
+
+	stored, err := pm.store.Tasks().ListDeleted(r.Context(), projectID)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	tasks := []Task{}
+	for _, s := range stored {
+		t := toAPITask(s)
+
+		notes, err := pm.getNotesForTask(t.ID)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		t.Notes = notes
+
+		labels, err := pm.getLabelsForTask(t.ID)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+			return
+		}
+		t.Labels = labels
+
+		tasks = append(tasks, t)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tasks)
+}
+
+// GetExpiringTasks lists tasks whose retention deadline falls within the
+// next ?within= duration (default 24h), so an operator can see what the
+// sweeper is about to hard-delete before it runs.
+func (pm *ProjectManager) GetExpiringTasks(w http.ResponseWriter, r *http.Request) {
+	window := 24 * time.Hour
+	if raw := r.URL.Query().Get("within"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid within duration")
+			return
+		}
+		window = d
+	}
+
+	stored, err := pm.store.Tasks().ListExpiring(r.Context(), window)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		return
+	}
+
+	tasks := make([]Task, 0, len(stored))
+	for _, s := range stored {
+		tasks = append(tasks, toAPITask(s))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tasks)
+}
+
+// RecoverTask restores a soft-deleted task
+func (pm *ProjectManager) RecoverTask(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	taskID, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, "Invalid task ID")
+		return
+	}
+
+	var recoverRequest struct {
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&recoverRequest); err != nil {
+		writeJSONError(w, http.StatusBadRequest, ErrValidation, err.Error())
+		return
+	}
+
+	// Default to "todo" if no status provided
+	if recoverRequest.Status == "" {
+		recoverRequest.Status = "todo"
+	}
+
+	// Recover the task by clearing deletion fields and setting new status
+	stored, err := pm.store.Tasks().Recover(r.Context(), taskID, recoverRequest.Status)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			writeJSONError(w, http.StatusNotFound, ErrNotFound, "Deleted task not found")
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
+		}
+		return
 	}
+	task := toAPITask(stored)
 
 	// Fetch notes for this task
 	notes, err := pm.getNotesForTask(task.ID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeJSONError(w, http.StatusInternalServerError, ErrInternal, err.Error())
 		return
 	}
 	task.Notes = notes
@@ -6,25 +6,52 @@ import (
 
 // Project represents a project in the system
 type Project struct {
+	ID             int        `json:"id" db:"id"`
+	Name           string     `json:"name" db:"name"`
+	Description    string     `json:"description" db:"description"`
+	Status         string     `json:"status" db:"status"`
+	ProjectGroupID *int       `json:"project_group_id" db:"project_group_id"` // nullable
+	Favorite       bool       `json:"favorite" db:"favorite"`
+	EndTime        *time.Time `json:"end_time" db:"end_time"` // nullable
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// ProjectGroup represents a hierarchical grouping of projects, e.g. a client
+// or initiative that several projects belong to.
+type ProjectGroup struct {
 	ID          int       `json:"id" db:"id"`
 	Name        string    `json:"name" db:"name"`
 	Description string    `json:"description" db:"description"`
-	Status      string    `json:"status" db:"status"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ProjectFilter narrows a project listing. Zero-value fields are ignored.
+type ProjectFilter struct {
+	IDs             []int
+	Active          *bool
+	Favorite        *bool
+	Ungrouped       bool
+	ProjectGroupIDs []int
+	Expiring        bool // projects with an EndTime within the next 7 days
 }
 
 // Task represents a task within a project
 type Task struct {
-	ID          int       `json:"id" db:"id"`
-	ProjectID   int       `json:"project_id" db:"project_id"`
-	Title       string    `json:"title" db:"title"`
-	Description string    `json:"description" db:"description"`
-	Status      string    `json:"status" db:"status"`
-	Priority    string    `json:"priority" db:"priority"`
-	AssignedTo  string    `json:"assigned_to" db:"assigned_to"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID            int           `json:"id" db:"id"`
+	ProjectID     int           `json:"project_id" db:"project_id"`
+	ParentTaskID  *int          `json:"parent_task_id" db:"parent_task_id"` // nullable; set for subtasks
+	Title         string        `json:"title" db:"title"`
+	Description   string        `json:"description" db:"description"`
+	Status        string        `json:"status" db:"status"`
+	Priority      string        `json:"priority" db:"priority"`
+	AssignedTo    string        `json:"assigned_to" db:"assigned_to"`
+	Duration      time.Duration `json:"duration" db:"duration"` // accumulated time spent
+	CompletedAt   *time.Time    `json:"completed_at" db:"completed_at"`
+	CreatedAt     time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at" db:"updated_at"`
+	Subtasks      []*Task       `json:"subtasks,omitempty" db:"-"`
+	TimeSpent     time.Duration `json:"time_spent,omitempty" db:"-"` // aggregated duration including subtasks
 }
 
 // Note represents a note or comment on a project or task
@@ -58,3 +85,71 @@ const (
 	ProjectStatusComplete = "complete"
 	ProjectStatusArchived = "archived"
 )
+
+// Requirement represents a project requirement or acceptance criterion,
+// tracked independently of the tasks that implement it.
+type Requirement struct {
+	ID                 int       `json:"id" db:"id"`
+	ProjectID          int       `json:"project_id" db:"project_id"`
+	Title              string    `json:"title" db:"title"`
+	Description        string    `json:"description" db:"description"`
+	Category           string    `json:"category" db:"category"`
+	Priority           string    `json:"priority" db:"priority"`
+	Status             string    `json:"status" db:"status"`
+	AcceptanceCriteria []string  `json:"acceptance_criteria" db:"acceptance_criteria"`
+	CreatedAt          time.Time `json:"created_at" db:"created_at"`
+}
+
+// RequirementStatus constants
+const (
+	RequirementStatusDraft    = "draft"
+	RequirementStatusApproved = "approved"
+	RequirementStatusInWork   = "in_work"
+	RequirementStatusDone     = "done"
+)
+
+// Sprint represents a time-boxed iteration of work on a project.
+type Sprint struct {
+	ID        int        `json:"id" db:"id"`
+	ProjectID int        `json:"project_id" db:"project_id"`
+	Name      string     `json:"name" db:"name"`
+	StartDate time.Time  `json:"start_date" db:"start_date"`
+	EndDate   time.Time  `json:"end_date" db:"end_date"`
+	Goal      string     `json:"goal" db:"goal"`
+	Status    string     `json:"status" db:"status"`
+}
+
+// SprintStatus constants
+const (
+	SprintStatusPlanned = "planned"
+	SprintStatusActive  = "active"
+	SprintStatusClosed  = "closed"
+)
+
+// BurndownPoint is the completed/remaining task count for a single day of a sprint.
+type BurndownPoint struct {
+	Date      time.Time `json:"date"`
+	Completed int       `json:"completed"`
+	Remaining int       `json:"remaining"`
+}
+
+// SprintStats summarizes burndown and velocity for a sprint.
+type SprintStats struct {
+	SprintID  int             `json:"sprint_id"`
+	Burndown  []BurndownPoint `json:"burndown"`
+	Velocity  float64         `json:"velocity"` // avg completed tasks/sprint over last N sprints
+}
+
+// TaskFilter narrows a server-side task listing. Zero-value fields are ignored.
+type TaskFilter struct {
+	ProjectIDs    []int
+	Statuses      []string
+	Priorities    []string
+	AssignedTo    string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	SearchText    string
+	Limit         int
+	Offset        int
+	OrderBy       string // e.g. "created_at DESC"; validated against a column whitelist
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // ProjectRepository defines the interface for project data operations
 type ProjectRepository interface {
 	// Project operations
@@ -8,6 +10,14 @@ type ProjectRepository interface {
 	GetAllProjects() ([]*Project, error)
 	UpdateProject(project *Project) error
 	DeleteProject(id int) error
+	ListProjects(filter ProjectFilter) ([]*Project, error)
+	GetProjectsByGroup(groupID int) ([]*Project, error)
+	GetUngroupedProjects() ([]*Project, error)
+
+	// Project group operations
+	CreateProjectGroup(group *ProjectGroup) error
+	GetProjectGroup(id int) (*ProjectGroup, error)
+	GetAllProjectGroups() ([]*ProjectGroup, error)
 
 	// Task operations
 	CreateTask(task *Task) error
@@ -16,12 +26,38 @@ type ProjectRepository interface {
 	GetAllTasks() ([]*Task, error)
 	UpdateTask(task *Task) error
 	DeleteTask(id int) error
+	ListTasks(filter TaskFilter) ([]*Task, int, error) // returns page plus total matching count
+
+	// Subtask operations
+	CreateSubtask(task *Task) error
+	GetSubtasks(parentID int) ([]*Task, error)
+	CompleteSubtask(id int, duration time.Duration) error
+	AddTaskDuration(id int, duration time.Duration) error
+
+	// Sprint operations
+	CreateSprint(sprint *Sprint) error
+	GetSprint(id int) (*Sprint, error)
+	GetActiveSprint(projectID int) (*Sprint, error)
+	GetSprintsByProject(projectID int) ([]*Sprint, error)
+	UpdateSprintStatus(id int, status string) error
+	AssignTaskToSprint(sprintID, taskID int) error
+	RemoveTaskFromSprint(sprintID, taskID int) error
+	GetSprintTasks(sprintID int) ([]*Task, error)
 
 	// Note operations
 	CreateNote(note *Note) error
 	GetNotesByProject(projectID int) ([]*Note, error)
 	GetNotesByTask(taskID int) ([]*Note, error)
 	DeleteNote(id int) error
+
+	// Requirement operations
+	CreateRequirement(requirement *Requirement) error
+	GetRequirement(id int) (*Requirement, error)
+	GetRequirementsByProject(projectID int) ([]*Requirement, error)
+	UpdateRequirementStatus(id int, status string) error
+	LinkRequirementToTask(requirementID, taskID int) error
+	UnlinkRequirementFromTask(requirementID, taskID int) error
+	GetTasksByRequirement(requirementID int) ([]*Task, error)
 }
 
 // ProjectService defines the business logic interface
@@ -31,6 +67,13 @@ type ProjectService interface {
 	GetProject(id int) (*Project, error)
 	GetAllProjects() ([]*Project, error)
 	UpdateProjectStatus(id int, status string) error
+	ListProjects(filter ProjectFilter) ([]*Project, error)
+	SetProjectFavorite(id int, favorite bool) error
+	AssignProjectGroup(projectID int, groupID *int) error
+	CreateProjectGroup(name, description string) (*ProjectGroup, error)
+	GetProjectGroups() ([]*ProjectGroup, error)
+	GetProjectsByGroup(groupID int) ([]*Project, error)
+	GetUngroupedProjects() ([]*Project, error)
 
 	// Task management
 	CreateTask(projectID int, title, description, priority string) (*Task, error)
@@ -39,6 +82,17 @@ type ProjectService interface {
 	GetAllTasks() ([]*Task, error)
 	UpdateTaskStatus(id int, status string) error
 	UpdateTaskPriority(id int, priority string) error
+	ListTasks(filter TaskFilter) ([]*Task, int, error)
+	CreateSubtask(parentTaskID int, title, description, priority string) (*Task, error)
+	GetSubtasks(parentID int) ([]*Task, error)
+	CompleteSubtask(id int, duration time.Duration) error
+	LogTaskTime(id int, duration time.Duration) error
+
+	// Sprint management
+	GetActiveSprint(projectID int) (*Sprint, error)
+	StartSprint(projectID int, name, goal string, startDate, endDate time.Time) (*Sprint, error)
+	CloseSprint(id int) error
+	GetSprintBurndown(sprintID int) (*SprintStats, error)
 
 	// Note management
 	AddNote(projectID int, taskID *int, content string) (*Note, error)
@@ -48,6 +102,22 @@ type ProjectService interface {
 	// Statistics and reporting
 	GetProjectStats(projectID int) (*ProjectStats, error)
 	GetOverallStats() (*OverallStats, error)
+
+	// Requirement management
+	CreateRequirement(projectID int, title, description, category, priority string, acceptanceCriteria []string) (*Requirement, error)
+	GetRequirementsByProject(projectID int) ([]*Requirement, error)
+	LinkRequirementToTask(requirementID, taskID int) error
+	UpdateRequirementStatus(id int, status string) error
+	GetRequirementCompletion(requirementID int) (*RequirementCompletion, error)
+}
+
+// RequirementCompletion reports how much of a requirement's linked work is done,
+// derived from the statuses of the tasks implementing it.
+type RequirementCompletion struct {
+	RequirementID  int     `json:"requirement_id"`
+	LinkedTasks    int     `json:"linked_tasks"`
+	CompletedTasks int     `json:"completed_tasks"`
+	PercentDone    float64 `json:"percent_done"`
 }
 
 // ProjectStats represents statistics for a specific project
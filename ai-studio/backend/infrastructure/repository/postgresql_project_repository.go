@@ -6,7 +6,8 @@ import (
 	"time"
 
 	"github.com/ai-studio/backend/domain"
-	_ "github.com/lib/pq"
+	"github.com/ai-studio/backend/infrastructure/migrations"
+	"github.com/lib/pq"
 )
 
 type PostgreSQLProjectRepository struct {
@@ -17,54 +18,29 @@ func NewPostgreSQLProjectRepository(db *sql.DB) *PostgreSQLProjectRepository {
 	return &PostgreSQLProjectRepository{db: db}
 }
 
-// Initialize creates the required tables if they don't exist
+// Initialize runs any pending migrations, bringing the database schema up to
+// date. It is safe to call on every startup.
 func (r *PostgreSQLProjectRepository) Initialize() error {
-	queries := []string{
-		`CREATE TABLE IF NOT EXISTS projects (
-			id SERIAL PRIMARY KEY,
-			name VARCHAR(255) NOT NULL,
-			description TEXT,
-			status VARCHAR(50) DEFAULT 'active',
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-		)`,
-		`CREATE TABLE IF NOT EXISTS tasks (
-			id SERIAL PRIMARY KEY,
-			project_id INTEGER REFERENCES projects(id),
-			title VARCHAR(255) NOT NULL,
-			description TEXT,
-			status VARCHAR(50) DEFAULT 'todo',
-			priority VARCHAR(20) DEFAULT 'medium',
-			assigned_to VARCHAR(100),
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-		)`,
-		`CREATE TABLE IF NOT EXISTS notes (
-			id SERIAL PRIMARY KEY,
-			project_id INTEGER REFERENCES projects(id),
-			task_id INTEGER REFERENCES tasks(id),
-			content TEXT NOT NULL,
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-		)`,
-	}
-
-	for _, query := range queries {
-		if _, err := r.db.Exec(query); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
-		}
-	}
-
-	return nil
+	return migrations.Run(r.db, "postgres")
 }
 
 // Project operations
+
+const projectColumns = `id, name, description, status, project_group_id, favorite, end_time, created_at, updated_at`
+
+func scanProject(scanner interface{ Scan(...interface{}) error }, project *domain.Project) error {
+	return scanner.Scan(&project.ID, &project.Name, &project.Description, &project.Status,
+		&project.ProjectGroupID, &project.Favorite, &project.EndTime, &project.CreatedAt, &project.UpdatedAt)
+}
+
 func (r *PostgreSQLProjectRepository) CreateProject(project *domain.Project) error {
 	query := `
-		INSERT INTO projects (name, description, status)
-		VALUES ($1, $2, $3)
+		INSERT INTO projects (name, description, status, project_group_id, favorite, end_time)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id, created_at, updated_at`
 
-	err := r.db.QueryRow(query, project.Name, project.Description, project.Status).
+	err := r.db.QueryRow(query, project.Name, project.Description, project.Status,
+		project.ProjectGroupID, project.Favorite, project.EndTime).
 		Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
 
 	return err
@@ -72,12 +48,9 @@ func (r *PostgreSQLProjectRepository) CreateProject(project *domain.Project) err
 
 func (r *PostgreSQLProjectRepository) GetProject(id int) (*domain.Project, error) {
 	project := &domain.Project{}
-	query := `SELECT id, name, description, status, created_at, updated_at FROM projects WHERE id = $1`
-
-	err := r.db.QueryRow(query, id).
-		Scan(&project.ID, &project.Name, &project.Description, &project.Status, &project.CreatedAt, &project.UpdatedAt)
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE id = $1`
 
-	if err != nil {
+	if err := scanProject(r.db.QueryRow(query, id), project); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +58,7 @@ func (r *PostgreSQLProjectRepository) GetProject(id int) (*domain.Project, error
 }
 
 func (r *PostgreSQLProjectRepository) GetAllProjects() ([]*domain.Project, error) {
-	query := `SELECT id, name, description, status, created_at, updated_at FROM projects ORDER BY created_at DESC`
+	query := `SELECT ` + projectColumns + ` FROM projects ORDER BY created_at DESC`
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -96,8 +69,7 @@ func (r *PostgreSQLProjectRepository) GetAllProjects() ([]*domain.Project, error
 	var projects []*domain.Project
 	for rows.Next() {
 		project := &domain.Project{}
-		err := rows.Scan(&project.ID, &project.Name, &project.Description, &project.Status, &project.CreatedAt, &project.UpdatedAt)
-		if err != nil {
+		if err := scanProject(rows, project); err != nil {
 			return nil, err
 		}
 		projects = append(projects, project)
@@ -106,13 +78,78 @@ func (r *PostgreSQLProjectRepository) GetAllProjects() ([]*domain.Project, error
 	return projects, nil
 }
 
+// ListProjects returns projects matching the given filter. Zero-value filter
+// fields are ignored, so an empty ProjectFilter behaves like GetAllProjects.
+func (r *PostgreSQLProjectRepository) ListProjects(filter domain.ProjectFilter) ([]*domain.Project, error) {
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE 1=1`
+	args := []interface{}{}
+
+	if len(filter.IDs) > 0 {
+		args = append(args, pq.Array(filter.IDs))
+		query += fmt.Sprintf(" AND id = ANY($%d)", len(args))
+	}
+
+	if filter.Active != nil {
+		args = append(args, domain.ProjectStatusActive)
+		if *filter.Active {
+			query += fmt.Sprintf(" AND status = $%d", len(args))
+		} else {
+			query += fmt.Sprintf(" AND status != $%d", len(args))
+		}
+	}
+
+	if filter.Favorite != nil {
+		args = append(args, *filter.Favorite)
+		query += fmt.Sprintf(" AND favorite = $%d", len(args))
+	}
+
+	if filter.Ungrouped {
+		query += " AND project_group_id IS NULL"
+	} else if len(filter.ProjectGroupIDs) > 0 {
+		args = append(args, pq.Array(filter.ProjectGroupIDs))
+		query += fmt.Sprintf(" AND project_group_id = ANY($%d)", len(args))
+	}
+
+	if filter.Expiring {
+		query += " AND end_time IS NOT NULL AND end_time BETWEEN CURRENT_TIMESTAMP AND CURRENT_TIMESTAMP + INTERVAL '7 days'"
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []*domain.Project
+	for rows.Next() {
+		project := &domain.Project{}
+		if err := scanProject(rows, project); err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+func (r *PostgreSQLProjectRepository) GetProjectsByGroup(groupID int) ([]*domain.Project, error) {
+	return r.ListProjects(domain.ProjectFilter{ProjectGroupIDs: []int{groupID}})
+}
+
+func (r *PostgreSQLProjectRepository) GetUngroupedProjects() ([]*domain.Project, error) {
+	return r.ListProjects(domain.ProjectFilter{Ungrouped: true})
+}
+
 func (r *PostgreSQLProjectRepository) UpdateProject(project *domain.Project) error {
 	query := `
-		UPDATE projects 
-		SET name = $1, description = $2, status = $3, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $4`
+		UPDATE projects
+		SET name = $1, description = $2, status = $3, project_group_id = $4, favorite = $5, end_time = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7`
 
-	_, err := r.db.Exec(query, project.Name, project.Description, project.Status, project.ID)
+	_, err := r.db.Exec(query, project.Name, project.Description, project.Status,
+		project.ProjectGroupID, project.Favorite, project.EndTime, project.ID)
 	return err
 }
 
@@ -122,14 +159,67 @@ func (r *PostgreSQLProjectRepository) DeleteProject(id int) error {
 	return err
 }
 
+// Project group operations
+func (r *PostgreSQLProjectRepository) CreateProjectGroup(group *domain.ProjectGroup) error {
+	query := `INSERT INTO project_groups (name, description) VALUES ($1, $2) RETURNING id, created_at`
+	return r.db.QueryRow(query, group.Name, group.Description).Scan(&group.ID, &group.CreatedAt)
+}
+
+func (r *PostgreSQLProjectRepository) GetProjectGroup(id int) (*domain.ProjectGroup, error) {
+	group := &domain.ProjectGroup{}
+	query := `SELECT id, name, description, created_at FROM project_groups WHERE id = $1`
+
+	err := r.db.QueryRow(query, id).Scan(&group.ID, &group.Name, &group.Description, &group.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (r *PostgreSQLProjectRepository) GetAllProjectGroups() ([]*domain.ProjectGroup, error) {
+	query := `SELECT id, name, description, created_at FROM project_groups ORDER BY name`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*domain.ProjectGroup
+	for rows.Next() {
+		group := &domain.ProjectGroup{}
+		if err := rows.Scan(&group.ID, &group.Name, &group.Description, &group.CreatedAt); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
 // Task operations
+
+const taskColumns = `id, project_id, parent_task_id, title, description, status, priority, assigned_to, duration_seconds, completed_at, created_at, updated_at`
+
+func scanTask(scanner interface{ Scan(...interface{}) error }, task *domain.Task) error {
+	var durationSeconds int64
+	err := scanner.Scan(&task.ID, &task.ProjectID, &task.ParentTaskID, &task.Title, &task.Description,
+		&task.Status, &task.Priority, &task.AssignedTo, &durationSeconds, &task.CompletedAt, &task.CreatedAt, &task.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	task.Duration = time.Duration(durationSeconds) * time.Second
+	return nil
+}
+
 func (r *PostgreSQLProjectRepository) CreateTask(task *domain.Task) error {
 	query := `
-		INSERT INTO tasks (project_id, title, description, status, priority, assigned_to)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO tasks (project_id, parent_task_id, title, description, status, priority, assigned_to)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id, created_at, updated_at`
 
-	err := r.db.QueryRow(query, task.ProjectID, task.Title, task.Description, task.Status, task.Priority, task.AssignedTo).
+	err := r.db.QueryRow(query, task.ProjectID, task.ParentTaskID, task.Title, task.Description, task.Status, task.Priority, task.AssignedTo).
 		Scan(&task.ID, &task.CreatedAt, &task.UpdatedAt)
 
 	return err
@@ -137,12 +227,9 @@ func (r *PostgreSQLProjectRepository) CreateTask(task *domain.Task) error {
 
 func (r *PostgreSQLProjectRepository) GetTask(id int) (*domain.Task, error) {
 	task := &domain.Task{}
-	query := `SELECT id, project_id, title, description, status, priority, assigned_to, created_at, updated_at FROM tasks WHERE id = $1`
-
-	err := r.db.QueryRow(query, id).
-		Scan(&task.ID, &task.ProjectID, &task.Title, &task.Description, &task.Status, &task.Priority, &task.AssignedTo, &task.CreatedAt, &task.UpdatedAt)
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1`
 
-	if err != nil {
+	if err := scanTask(r.db.QueryRow(query, id), task); err != nil {
 		return nil, err
 	}
 
@@ -150,7 +237,7 @@ func (r *PostgreSQLProjectRepository) GetTask(id int) (*domain.Task, error) {
 }
 
 func (r *PostgreSQLProjectRepository) GetTasksByProject(projectID int) ([]*domain.Task, error) {
-	query := `SELECT id, project_id, title, description, status, priority, assigned_to, created_at, updated_at FROM tasks WHERE project_id = $1 ORDER BY created_at DESC`
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE project_id = $1 ORDER BY created_at DESC`
 
 	rows, err := r.db.Query(query, projectID)
 	if err != nil {
@@ -161,8 +248,7 @@ func (r *PostgreSQLProjectRepository) GetTasksByProject(projectID int) ([]*domai
 	var tasks []*domain.Task
 	for rows.Next() {
 		task := &domain.Task{}
-		err := rows.Scan(&task.ID, &task.ProjectID, &task.Title, &task.Description, &task.Status, &task.Priority, &task.AssignedTo, &task.CreatedAt, &task.UpdatedAt)
-		if err != nil {
+		if err := scanTask(rows, task); err != nil {
 			return nil, err
 		}
 		tasks = append(tasks, task)
@@ -172,10 +258,7 @@ func (r *PostgreSQLProjectRepository) GetTasksByProject(projectID int) ([]*domai
 }
 
 func (r *PostgreSQLProjectRepository) GetAllTasks() ([]*domain.Task, error) {
-	query := `
-		SELECT t.id, t.project_id, t.title, t.description, t.status, t.priority, t.assigned_to, t.created_at, t.updated_at
-		FROM tasks t 
-		ORDER BY t.created_at DESC`
+	query := `SELECT ` + taskColumns + ` FROM tasks ORDER BY created_at DESC`
 
 	rows, err := r.db.Query(query)
 	if err != nil {
@@ -186,8 +269,7 @@ func (r *PostgreSQLProjectRepository) GetAllTasks() ([]*domain.Task, error) {
 	var tasks []*domain.Task
 	for rows.Next() {
 		task := &domain.Task{}
-		err := rows.Scan(&task.ID, &task.ProjectID, &task.Title, &task.Description, &task.Status, &task.Priority, &task.AssignedTo, &task.CreatedAt, &task.UpdatedAt)
-		if err != nil {
+		if err := scanTask(rows, task); err != nil {
 			return nil, err
 		}
 		tasks = append(tasks, task)
@@ -196,9 +278,106 @@ func (r *PostgreSQLProjectRepository) GetAllTasks() ([]*domain.Task, error) {
 	return tasks, nil
 }
 
+// taskOrderByWhitelist maps accepted TaskFilter.OrderBy values to safe SQL fragments.
+var taskOrderByWhitelist = map[string]string{
+	"":                "created_at DESC",
+	"created_at":      "created_at ASC",
+	"created_at DESC": "created_at DESC",
+	"updated_at":      "updated_at ASC",
+	"updated_at DESC": "updated_at DESC",
+	"priority":        "priority ASC",
+	"priority DESC":   "priority DESC",
+}
+
+// ListTasks builds the WHERE/ORDER BY/LIMIT clauses dynamically so filtering,
+// sorting, and pagination are pushed down to PostgreSQL instead of loading
+// every row and slicing in Go. It returns the page of tasks plus the total
+// count of tasks matching the filter (ignoring Limit/Offset).
+func (r *PostgreSQLProjectRepository) ListTasks(filter domain.TaskFilter) ([]*domain.Task, int, error) {
+	where := "WHERE 1=1"
+	args := []interface{}{}
+
+	if len(filter.ProjectIDs) > 0 {
+		args = append(args, pq.Array(filter.ProjectIDs))
+		where += fmt.Sprintf(" AND project_id = ANY($%d)", len(args))
+	}
+
+	if len(filter.Statuses) > 0 {
+		args = append(args, pq.Array(filter.Statuses))
+		where += fmt.Sprintf(" AND status = ANY($%d)", len(args))
+	}
+
+	if len(filter.Priorities) > 0 {
+		args = append(args, pq.Array(filter.Priorities))
+		where += fmt.Sprintf(" AND priority = ANY($%d)", len(args))
+	}
+
+	if filter.AssignedTo != "" {
+		args = append(args, filter.AssignedTo)
+		where += fmt.Sprintf(" AND assigned_to = $%d", len(args))
+	}
+
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		where += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		where += fmt.Sprintf(" AND created_at <= $%d", len(args))
+	}
+
+	if filter.SearchText != "" {
+		args = append(args, "%"+filter.SearchText+"%")
+		where += fmt.Sprintf(" AND (title ILIKE $%d OR description ILIKE $%d)", len(args), len(args))
+	}
+
+	var total int
+	countQuery := "SELECT COUNT(*) FROM tasks " + where
+	if err := r.db.QueryRow(countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := taskOrderByWhitelist[filter.OrderBy]
+	if !ok {
+		orderBy = taskOrderByWhitelist[""]
+	}
+
+	query := "SELECT " + taskColumns + " FROM tasks " + where + " ORDER BY " + orderBy
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
+
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task := &domain.Task{}
+		if err := scanTask(rows, task); err != nil {
+			return nil, 0, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, total, nil
+}
+
 func (r *PostgreSQLProjectRepository) UpdateTask(task *domain.Task) error {
 	query := `
-		UPDATE tasks 
+		UPDATE tasks
 		SET title = $1, description = $2, status = $3, priority = $4, assigned_to = $5, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $6`
 
@@ -212,6 +391,48 @@ func (r *PostgreSQLProjectRepository) DeleteTask(id int) error {
 	return err
 }
 
+// Subtask operations
+func (r *PostgreSQLProjectRepository) CreateSubtask(task *domain.Task) error {
+	return r.CreateTask(task)
+}
+
+func (r *PostgreSQLProjectRepository) GetSubtasks(parentID int) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE parent_task_id = $1 ORDER BY created_at ASC`
+
+	rows, err := r.db.Query(query, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subtasks []*domain.Task
+	for rows.Next() {
+		subtask := &domain.Task{}
+		if err := scanTask(rows, subtask); err != nil {
+			return nil, err
+		}
+		subtasks = append(subtasks, subtask)
+	}
+
+	return subtasks, nil
+}
+
+func (r *PostgreSQLProjectRepository) CompleteSubtask(id int, duration time.Duration) error {
+	query := `
+		UPDATE tasks
+		SET status = $1, duration_seconds = duration_seconds + $2, completed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3`
+
+	_, err := r.db.Exec(query, domain.TaskStatusDone, int64(duration.Seconds()), id)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) AddTaskDuration(id int, duration time.Duration) error {
+	query := `UPDATE tasks SET duration_seconds = duration_seconds + $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`
+	_, err := r.db.Exec(query, int64(duration.Seconds()), id)
+	return err
+}
+
 // Note operations
 func (r *PostgreSQLProjectRepository) CreateNote(note *domain.Note) error {
 	query := `
@@ -274,3 +495,200 @@ func (r *PostgreSQLProjectRepository) DeleteNote(id int) error {
 	_, err := r.db.Exec(query, id)
 	return err
 }
+
+// Requirement operations
+func (r *PostgreSQLProjectRepository) CreateRequirement(requirement *domain.Requirement) error {
+	query := `
+		INSERT INTO requirements (project_id, title, description, category, priority, status, acceptance_criteria)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(query, requirement.ProjectID, requirement.Title, requirement.Description,
+		requirement.Category, requirement.Priority, requirement.Status, pq.Array(requirement.AcceptanceCriteria)).
+		Scan(&requirement.ID, &requirement.CreatedAt)
+
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) GetRequirement(id int) (*domain.Requirement, error) {
+	requirement := &domain.Requirement{}
+	query := `SELECT id, project_id, title, description, category, priority, status, acceptance_criteria, created_at FROM requirements WHERE id = $1`
+
+	err := r.db.QueryRow(query, id).
+		Scan(&requirement.ID, &requirement.ProjectID, &requirement.Title, &requirement.Description,
+			&requirement.Category, &requirement.Priority, &requirement.Status, pq.Array(&requirement.AcceptanceCriteria), &requirement.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return requirement, nil
+}
+
+func (r *PostgreSQLProjectRepository) GetRequirementsByProject(projectID int) ([]*domain.Requirement, error) {
+	query := `
+		SELECT id, project_id, title, description, category, priority, status, acceptance_criteria, created_at
+		FROM requirements WHERE project_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requirements []*domain.Requirement
+	for rows.Next() {
+		requirement := &domain.Requirement{}
+		err := rows.Scan(&requirement.ID, &requirement.ProjectID, &requirement.Title, &requirement.Description,
+			&requirement.Category, &requirement.Priority, &requirement.Status, pq.Array(&requirement.AcceptanceCriteria), &requirement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+func (r *PostgreSQLProjectRepository) UpdateRequirementStatus(id int, status string) error {
+	query := `UPDATE requirements SET status = $1 WHERE id = $2`
+	_, err := r.db.Exec(query, status, id)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) LinkRequirementToTask(requirementID, taskID int) error {
+	query := `INSERT INTO requirement_tasks (requirement_id, task_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := r.db.Exec(query, requirementID, taskID)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) UnlinkRequirementFromTask(requirementID, taskID int) error {
+	query := `DELETE FROM requirement_tasks WHERE requirement_id = $1 AND task_id = $2`
+	_, err := r.db.Exec(query, requirementID, taskID)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) GetTasksByRequirement(requirementID int) ([]*domain.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (SELECT task_id FROM requirement_tasks WHERE requirement_id = $1)
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query, requirementID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task := &domain.Task{}
+		if err := scanTask(rows, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// Sprint operations
+func (r *PostgreSQLProjectRepository) CreateSprint(sprint *domain.Sprint) error {
+	query := `
+		INSERT INTO sprints (project_id, name, start_date, end_date, goal, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`
+
+	return r.db.QueryRow(query, sprint.ProjectID, sprint.Name, sprint.StartDate, sprint.EndDate, sprint.Goal, sprint.Status).Scan(&sprint.ID)
+}
+
+func (r *PostgreSQLProjectRepository) GetSprint(id int) (*domain.Sprint, error) {
+	sprint := &domain.Sprint{}
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE id = $1`
+
+	err := r.db.QueryRow(query, id).
+		Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *PostgreSQLProjectRepository) GetActiveSprint(projectID int) (*domain.Sprint, error) {
+	sprint := &domain.Sprint{}
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE project_id = $1 AND status = $2 ORDER BY start_date DESC LIMIT 1`
+
+	err := r.db.QueryRow(query, projectID, domain.SprintStatusActive).
+		Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *PostgreSQLProjectRepository) GetSprintsByProject(projectID int) ([]*domain.Sprint, error) {
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE project_id = $1 ORDER BY start_date DESC`
+
+	rows, err := r.db.Query(query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sprints []*domain.Sprint
+	for rows.Next() {
+		sprint := &domain.Sprint{}
+		if err := rows.Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status); err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, sprint)
+	}
+
+	return sprints, nil
+}
+
+func (r *PostgreSQLProjectRepository) UpdateSprintStatus(id int, status string) error {
+	query := `UPDATE sprints SET status = $1 WHERE id = $2`
+	_, err := r.db.Exec(query, status, id)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) AssignTaskToSprint(sprintID, taskID int) error {
+	query := `INSERT INTO sprint_tasks (sprint_id, task_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := r.db.Exec(query, sprintID, taskID)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) RemoveTaskFromSprint(sprintID, taskID int) error {
+	query := `DELETE FROM sprint_tasks WHERE sprint_id = $1 AND task_id = $2`
+	_, err := r.db.Exec(query, sprintID, taskID)
+	return err
+}
+
+func (r *PostgreSQLProjectRepository) GetSprintTasks(sprintID int) ([]*domain.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (SELECT task_id FROM sprint_tasks WHERE sprint_id = $1)
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task := &domain.Task{}
+		if err := scanTask(rows, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
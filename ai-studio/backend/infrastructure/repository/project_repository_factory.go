@@ -0,0 +1,45 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/ai-studio/backend/domain"
+)
+
+// NewProjectRepository opens db and returns the domain.ProjectRepository
+// implementation matching driver ("postgres" or "sqlite"), with its
+// migrations already applied. driver is typically read from the
+// PROJECT_DB_DRIVER environment variable, defaulting to "postgres" to match
+// existing deployments.
+func NewProjectRepository(driver string, db *sql.DB) (domain.ProjectRepository, error) {
+	var repo interface {
+		domain.ProjectRepository
+		Initialize() error
+	}
+
+	switch driver {
+	case "", "postgres":
+		repo = NewPostgreSQLProjectRepository(db)
+	case "sqlite":
+		repo = NewSQLiteProjectRepository(db)
+	default:
+		return nil, fmt.Errorf("unsupported project repository driver %q", driver)
+	}
+
+	if err := repo.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s project repository: %w", driver, err)
+	}
+
+	return repo, nil
+}
+
+// ProjectDBDriver reads the configured project repository driver from the
+// PROJECT_DB_DRIVER environment variable, defaulting to "postgres".
+func ProjectDBDriver() string {
+	if driver := os.Getenv("PROJECT_DB_DRIVER"); driver != "" {
+		return driver
+	}
+	return "postgres"
+}
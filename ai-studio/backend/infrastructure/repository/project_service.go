@@ -0,0 +1,439 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/ai-studio/backend/domain"
+)
+
+// ProjectManagerService implements domain.ProjectService on top of a
+// domain.ProjectRepository, translating business-level calls (e.g.
+// "create a project from a name and description") into the repository's
+// persistence-shaped ones (e.g. "insert this *domain.Project"). It works
+// with either PostgreSQLProjectRepository or SQLiteProjectRepository, since
+// both satisfy domain.ProjectRepository.
+type ProjectManagerService struct {
+	repo domain.ProjectRepository
+}
+
+// NewProjectManagerService wraps repo as a domain.ProjectService.
+func NewProjectManagerService(repo domain.ProjectRepository) *ProjectManagerService {
+	return &ProjectManagerService{repo: repo}
+}
+
+// ProjectManagerService is how both PostgreSQLProjectRepository and
+// SQLiteProjectRepository reach NewProjectManagerApp: neither satisfies
+// domain.ProjectService directly (their CreateProject/CreateTask/etc
+// signatures are shaped for persistence, not business calls), so this
+// wrapper is what the assertion below actually checks.
+var _ domain.ProjectService = (*ProjectManagerService)(nil)
+
+// Project management
+
+func (s *ProjectManagerService) CreateProject(name, description string) (*domain.Project, error) {
+	project := &domain.Project{
+		Name:        name,
+		Description: description,
+		Status:      domain.ProjectStatusActive,
+	}
+	if err := s.repo.CreateProject(project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (s *ProjectManagerService) GetProject(id int) (*domain.Project, error) {
+	return s.repo.GetProject(id)
+}
+
+func (s *ProjectManagerService) GetAllProjects() ([]*domain.Project, error) {
+	return s.repo.GetAllProjects()
+}
+
+func (s *ProjectManagerService) UpdateProjectStatus(id int, status string) error {
+	project, err := s.repo.GetProject(id)
+	if err != nil {
+		return err
+	}
+	project.Status = status
+	return s.repo.UpdateProject(project)
+}
+
+func (s *ProjectManagerService) ListProjects(filter domain.ProjectFilter) ([]*domain.Project, error) {
+	return s.repo.ListProjects(filter)
+}
+
+func (s *ProjectManagerService) SetProjectFavorite(id int, favorite bool) error {
+	project, err := s.repo.GetProject(id)
+	if err != nil {
+		return err
+	}
+	project.Favorite = favorite
+	return s.repo.UpdateProject(project)
+}
+
+func (s *ProjectManagerService) AssignProjectGroup(projectID int, groupID *int) error {
+	project, err := s.repo.GetProject(projectID)
+	if err != nil {
+		return err
+	}
+	project.ProjectGroupID = groupID
+	return s.repo.UpdateProject(project)
+}
+
+func (s *ProjectManagerService) CreateProjectGroup(name, description string) (*domain.ProjectGroup, error) {
+	group := &domain.ProjectGroup{Name: name, Description: description}
+	if err := s.repo.CreateProjectGroup(group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (s *ProjectManagerService) GetProjectGroups() ([]*domain.ProjectGroup, error) {
+	return s.repo.GetAllProjectGroups()
+}
+
+func (s *ProjectManagerService) GetProjectsByGroup(groupID int) ([]*domain.Project, error) {
+	return s.repo.GetProjectsByGroup(groupID)
+}
+
+func (s *ProjectManagerService) GetUngroupedProjects() ([]*domain.Project, error) {
+	return s.repo.GetUngroupedProjects()
+}
+
+// Task management
+
+func (s *ProjectManagerService) CreateTask(projectID int, title, description, priority string) (*domain.Task, error) {
+	task := &domain.Task{
+		ProjectID:   projectID,
+		Title:       title,
+		Description: description,
+		Priority:    priority,
+		Status:      domain.TaskStatusTodo,
+	}
+	if err := s.repo.CreateTask(task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (s *ProjectManagerService) GetTask(id int) (*domain.Task, error) {
+	return s.repo.GetTask(id)
+}
+
+func (s *ProjectManagerService) GetTasksByProject(projectID int) ([]*domain.Task, error) {
+	return s.repo.GetTasksByProject(projectID)
+}
+
+func (s *ProjectManagerService) GetAllTasks() ([]*domain.Task, error) {
+	return s.repo.GetAllTasks()
+}
+
+func (s *ProjectManagerService) UpdateTaskStatus(id int, status string) error {
+	task, err := s.repo.GetTask(id)
+	if err != nil {
+		return err
+	}
+	task.Status = status
+	return s.repo.UpdateTask(task)
+}
+
+func (s *ProjectManagerService) UpdateTaskPriority(id int, priority string) error {
+	task, err := s.repo.GetTask(id)
+	if err != nil {
+		return err
+	}
+	task.Priority = priority
+	return s.repo.UpdateTask(task)
+}
+
+func (s *ProjectManagerService) ListTasks(filter domain.TaskFilter) ([]*domain.Task, int, error) {
+	return s.repo.ListTasks(filter)
+}
+
+func (s *ProjectManagerService) CreateSubtask(parentTaskID int, title, description, priority string) (*domain.Task, error) {
+	parent, err := s.repo.GetTask(parentTaskID)
+	if err != nil {
+		return nil, err
+	}
+	subtask := &domain.Task{
+		ProjectID:    parent.ProjectID,
+		ParentTaskID: &parentTaskID,
+		Title:        title,
+		Description:  description,
+		Priority:     priority,
+		Status:       domain.TaskStatusTodo,
+	}
+	if err := s.repo.CreateSubtask(subtask); err != nil {
+		return nil, err
+	}
+	return subtask, nil
+}
+
+func (s *ProjectManagerService) GetSubtasks(parentID int) ([]*domain.Task, error) {
+	return s.repo.GetSubtasks(parentID)
+}
+
+func (s *ProjectManagerService) CompleteSubtask(id int, duration time.Duration) error {
+	return s.repo.CompleteSubtask(id, duration)
+}
+
+// LogTaskTime records time spent on a task without completing it; the repo
+// exposes this as AddTaskDuration, keeping it distinct from CompleteSubtask
+// which also marks the task done.
+func (s *ProjectManagerService) LogTaskTime(id int, duration time.Duration) error {
+	return s.repo.AddTaskDuration(id, duration)
+}
+
+// Sprint management
+
+func (s *ProjectManagerService) GetActiveSprint(projectID int) (*domain.Sprint, error) {
+	return s.repo.GetActiveSprint(projectID)
+}
+
+func (s *ProjectManagerService) StartSprint(projectID int, name, goal string, startDate, endDate time.Time) (*domain.Sprint, error) {
+	sprint := &domain.Sprint{
+		ProjectID: projectID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Status:    domain.SprintStatusActive,
+	}
+	if err := s.repo.CreateSprint(sprint); err != nil {
+		return nil, err
+	}
+	return sprint, nil
+}
+
+func (s *ProjectManagerService) CloseSprint(id int) error {
+	return s.repo.UpdateSprintStatus(id, domain.SprintStatusClosed)
+}
+
+// velocityWindow is how many of a project's most recent closed sprints feed
+// into GetSprintBurndown's velocity figure.
+const velocityWindow = 3
+
+// GetSprintBurndown walks sprintID's linked tasks day by day from the
+// sprint's start to its end (or today, if it's still running), reporting how
+// many were done and how many remained at the end of each day. Velocity is
+// the average number of tasks completed per sprint over the project's last
+// velocityWindow closed sprints, excluding sprintID itself.
+func (s *ProjectManagerService) GetSprintBurndown(sprintID int) (*domain.SprintStats, error) {
+	sprint, err := s.repo.GetSprint(sprintID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.repo.GetSprintTasks(sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	end := sprint.EndDate
+	if now := time.Now(); now.Before(end) {
+		end = now
+	}
+
+	var burndown []domain.BurndownPoint
+	last := truncateToDay(end)
+	for day := truncateToDay(sprint.StartDate); !day.After(last); day = day.AddDate(0, 0, 1) {
+		completed := 0
+		for _, t := range tasks {
+			if t.CompletedAt != nil && t.CompletedAt.Before(day.AddDate(0, 0, 1)) {
+				completed++
+			}
+		}
+		burndown = append(burndown, domain.BurndownPoint{
+			Date:      day,
+			Completed: completed,
+			Remaining: len(tasks) - completed,
+		})
+	}
+
+	velocity, err := s.sprintVelocity(sprint.ProjectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SprintStats{SprintID: sprintID, Burndown: burndown, Velocity: velocity}, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// sprintVelocity averages completed task counts over the project's last
+// velocityWindow closed sprints, excluding excludeSprintID (the sprint the
+// caller is currently viewing the burndown for, which may still be open).
+func (s *ProjectManagerService) sprintVelocity(projectID, excludeSprintID int) (float64, error) {
+	sprints, err := s.repo.GetSprintsByProject(projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	var completedCounts []int
+	for _, sprint := range sprints {
+		if sprint.ID == excludeSprintID || sprint.Status != domain.SprintStatusClosed {
+			continue
+		}
+		tasks, err := s.repo.GetSprintTasks(sprint.ID)
+		if err != nil {
+			return 0, err
+		}
+		done := 0
+		for _, t := range tasks {
+			if t.Status == domain.TaskStatusDone {
+				done++
+			}
+		}
+		completedCounts = append(completedCounts, done)
+		if len(completedCounts) == velocityWindow {
+			break
+		}
+	}
+
+	if len(completedCounts) == 0 {
+		return 0, nil
+	}
+	total := 0
+	for _, c := range completedCounts {
+		total += c
+	}
+	return float64(total) / float64(len(completedCounts)), nil
+}
+
+// Note management
+
+func (s *ProjectManagerService) AddNote(projectID int, taskID *int, content string) (*domain.Note, error) {
+	note := &domain.Note{ProjectID: projectID, TaskID: taskID, Content: content}
+	if err := s.repo.CreateNote(note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+func (s *ProjectManagerService) GetProjectNotes(projectID int) ([]*domain.Note, error) {
+	return s.repo.GetNotesByProject(projectID)
+}
+
+func (s *ProjectManagerService) GetTaskNotes(taskID int) ([]*domain.Note, error) {
+	return s.repo.GetNotesByTask(taskID)
+}
+
+// Statistics and reporting
+
+func (s *ProjectManagerService) GetProjectStats(projectID int) (*domain.ProjectStats, error) {
+	tasks, err := s.repo.GetTasksByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.ProjectStats{ProjectID: projectID, TotalTasks: len(tasks)}
+	for _, t := range tasks {
+		switch t.Status {
+		case domain.TaskStatusDone:
+			stats.CompletedTasks++
+		case domain.TaskStatusInProgress:
+			stats.InProgressTasks++
+		case domain.TaskStatusTodo:
+			stats.TodoTasks++
+		case domain.TaskStatusReview:
+			stats.ReviewTasks++
+		}
+		switch t.Priority {
+		case domain.TaskPriorityHigh:
+			stats.HighPriorityTasks++
+		case domain.TaskPriorityUrgent:
+			stats.UrgentTasks++
+		}
+	}
+	return stats, nil
+}
+
+func (s *ProjectManagerService) GetOverallStats() (*domain.OverallStats, error) {
+	projects, err := s.repo.GetAllProjects()
+	if err != nil {
+		return nil, err
+	}
+	projectByID := make(map[int]*domain.Project, len(projects))
+
+	stats := &domain.OverallStats{TotalProjects: len(projects)}
+	for _, p := range projects {
+		projectByID[p.ID] = p
+		if p.Status == domain.ProjectStatusActive {
+			stats.ActiveProjects++
+		}
+	}
+
+	tasks, err := s.repo.GetAllTasks()
+	if err != nil {
+		return nil, err
+	}
+	stats.TotalTasks = len(tasks)
+	now := time.Now()
+	for _, t := range tasks {
+		switch t.Status {
+		case domain.TaskStatusDone:
+			stats.CompletedTasks++
+			continue
+		case domain.TaskStatusInProgress:
+			stats.InProgressTasks++
+		}
+		if project, ok := projectByID[t.ProjectID]; ok && project.EndTime != nil && project.EndTime.Before(now) {
+			stats.OverdueTasks++
+		}
+	}
+
+	return stats, nil
+}
+
+// Requirement management
+
+func (s *ProjectManagerService) CreateRequirement(projectID int, title, description, category, priority string, acceptanceCriteria []string) (*domain.Requirement, error) {
+	requirement := &domain.Requirement{
+		ProjectID:          projectID,
+		Title:              title,
+		Description:        description,
+		Category:           category,
+		Priority:           priority,
+		Status:             domain.RequirementStatusDraft,
+		AcceptanceCriteria: acceptanceCriteria,
+	}
+	if err := s.repo.CreateRequirement(requirement); err != nil {
+		return nil, err
+	}
+	return requirement, nil
+}
+
+func (s *ProjectManagerService) GetRequirementsByProject(projectID int) ([]*domain.Requirement, error) {
+	return s.repo.GetRequirementsByProject(projectID)
+}
+
+func (s *ProjectManagerService) LinkRequirementToTask(requirementID, taskID int) error {
+	return s.repo.LinkRequirementToTask(requirementID, taskID)
+}
+
+func (s *ProjectManagerService) UpdateRequirementStatus(id int, status string) error {
+	return s.repo.UpdateRequirementStatus(id, status)
+}
+
+// GetRequirementCompletion reports how much of a requirement's linked work is
+// done, as the fraction of its linked tasks whose status is "done". A
+// requirement with no linked tasks yet is reported at 0%, not NaN.
+func (s *ProjectManagerService) GetRequirementCompletion(requirementID int) (*domain.RequirementCompletion, error) {
+	tasks, err := s.repo.GetTasksByRequirement(requirementID)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := &domain.RequirementCompletion{RequirementID: requirementID, LinkedTasks: len(tasks)}
+	for _, t := range tasks {
+		if t.Status == domain.TaskStatusDone {
+			completion.CompletedTasks++
+		}
+	}
+	if completion.LinkedTasks > 0 {
+		completion.PercentDone = 100 * float64(completion.CompletedTasks) / float64(completion.LinkedTasks)
+	}
+	return completion, nil
+}
@@ -0,0 +1,650 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/ai-studio/backend/domain"
+	"github.com/ai-studio/backend/infrastructure/migrations"
+)
+
+// SQLiteProjectRepository is a domain.ProjectRepository backed by SQLite,
+// for single-user Wails deployments that don't have a PostgreSQL server
+// available. It implements the same interface as PostgreSQLProjectRepository
+// with the same table layout, differing only where the SQL dialects diverge
+// (placeholder style, and no native array/ANY() support).
+type SQLiteProjectRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteProjectRepository(db *sql.DB) *SQLiteProjectRepository {
+	return &SQLiteProjectRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up to
+// date. It is safe to call on every startup.
+func (r *SQLiteProjectRepository) Initialize() error {
+	return migrations.Run(r.db, "sqlite")
+}
+
+// Project operations
+
+func (r *SQLiteProjectRepository) CreateProject(project *domain.Project) error {
+	query := `INSERT INTO projects (name, description, status, project_group_id, favorite, end_time, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`
+
+	result, err := r.db.Exec(query, project.Name, project.Description, project.Status,
+		project.ProjectGroupID, project.Favorite, project.EndTime)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	project.ID = int(id)
+
+	return r.db.QueryRow(`SELECT created_at, updated_at FROM projects WHERE id = ?`, project.ID).
+		Scan(&project.CreatedAt, &project.UpdatedAt)
+}
+
+func (r *SQLiteProjectRepository) GetProject(id int) (*domain.Project, error) {
+	project := &domain.Project{}
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE id = ?`
+
+	if err := scanProject(r.db.QueryRow(query, id), project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+func (r *SQLiteProjectRepository) GetAllProjects() ([]*domain.Project, error) {
+	query := `SELECT ` + projectColumns + ` FROM projects ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []*domain.Project
+	for rows.Next() {
+		project := &domain.Project{}
+		if err := scanProject(rows, project); err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// ListProjects returns projects matching the given filter. Zero-value filter
+// fields are ignored, so an empty ProjectFilter behaves like GetAllProjects.
+func (r *SQLiteProjectRepository) ListProjects(filter domain.ProjectFilter) ([]*domain.Project, error) {
+	query := `SELECT ` + projectColumns + ` FROM projects WHERE 1=1`
+	args := []interface{}{}
+
+	if len(filter.IDs) > 0 {
+		query += " AND id IN (" + placeholders(len(filter.IDs)) + ")"
+		for _, id := range filter.IDs {
+			args = append(args, id)
+		}
+	}
+
+	if filter.Active != nil {
+		if *filter.Active {
+			query += " AND status = ?"
+		} else {
+			query += " AND status != ?"
+		}
+		args = append(args, domain.ProjectStatusActive)
+	}
+
+	if filter.Favorite != nil {
+		query += " AND favorite = ?"
+		args = append(args, *filter.Favorite)
+	}
+
+	if filter.Ungrouped {
+		query += " AND project_group_id IS NULL"
+	} else if len(filter.ProjectGroupIDs) > 0 {
+		query += " AND project_group_id IN (" + placeholders(len(filter.ProjectGroupIDs)) + ")"
+		for _, id := range filter.ProjectGroupIDs {
+			args = append(args, id)
+		}
+	}
+
+	if filter.Expiring {
+		query += " AND end_time IS NOT NULL AND end_time BETWEEN CURRENT_TIMESTAMP AND datetime(CURRENT_TIMESTAMP, '+7 days')"
+	}
+
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []*domain.Project
+	for rows.Next() {
+		project := &domain.Project{}
+		if err := scanProject(rows, project); err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+func (r *SQLiteProjectRepository) GetProjectsByGroup(groupID int) ([]*domain.Project, error) {
+	return r.ListProjects(domain.ProjectFilter{ProjectGroupIDs: []int{groupID}})
+}
+
+func (r *SQLiteProjectRepository) GetUngroupedProjects() ([]*domain.Project, error) {
+	return r.ListProjects(domain.ProjectFilter{Ungrouped: true})
+}
+
+func (r *SQLiteProjectRepository) UpdateProject(project *domain.Project) error {
+	query := `
+		UPDATE projects
+		SET name = ?, description = ?, status = ?, project_group_id = ?, favorite = ?, end_time = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+
+	_, err := r.db.Exec(query, project.Name, project.Description, project.Status,
+		project.ProjectGroupID, project.Favorite, project.EndTime, project.ID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) DeleteProject(id int) error {
+	_, err := r.db.Exec(`DELETE FROM projects WHERE id = ?`, id)
+	return err
+}
+
+// Project group operations
+
+func (r *SQLiteProjectRepository) CreateProjectGroup(group *domain.ProjectGroup) error {
+	result, err := r.db.Exec(`INSERT INTO project_groups (name, description, created_at) VALUES (?, ?, CURRENT_TIMESTAMP)`,
+		group.Name, group.Description)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	group.ID = int(id)
+
+	return r.db.QueryRow(`SELECT created_at FROM project_groups WHERE id = ?`, group.ID).Scan(&group.CreatedAt)
+}
+
+func (r *SQLiteProjectRepository) GetProjectGroup(id int) (*domain.ProjectGroup, error) {
+	group := &domain.ProjectGroup{}
+	query := `SELECT id, name, description, created_at FROM project_groups WHERE id = ?`
+
+	if err := r.db.QueryRow(query, id).Scan(&group.ID, &group.Name, &group.Description, &group.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (r *SQLiteProjectRepository) GetAllProjectGroups() ([]*domain.ProjectGroup, error) {
+	query := `SELECT id, name, description, created_at FROM project_groups ORDER BY name`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*domain.ProjectGroup
+	for rows.Next() {
+		group := &domain.ProjectGroup{}
+		if err := rows.Scan(&group.ID, &group.Name, &group.Description, &group.CreatedAt); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// Task operations
+
+func (r *SQLiteProjectRepository) CreateTask(task *domain.Task) error {
+	query := `
+		INSERT INTO tasks (project_id, parent_task_id, title, description, status, priority, assigned_to, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`
+
+	result, err := r.db.Exec(query, task.ProjectID, task.ParentTaskID, task.Title, task.Description, task.Status, task.Priority, task.AssignedTo)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	task.ID = int(id)
+
+	return r.db.QueryRow(`SELECT created_at, updated_at FROM tasks WHERE id = ?`, task.ID).Scan(&task.CreatedAt, &task.UpdatedAt)
+}
+
+func (r *SQLiteProjectRepository) GetTask(id int) (*domain.Task, error) {
+	task := &domain.Task{}
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = ?`
+
+	if err := scanTask(r.db.QueryRow(query, id), task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (r *SQLiteProjectRepository) GetTasksByProject(projectID int) ([]*domain.Task, error) {
+	return r.queryTasks(`SELECT `+taskColumns+` FROM tasks WHERE project_id = ? ORDER BY created_at DESC`, projectID)
+}
+
+func (r *SQLiteProjectRepository) GetAllTasks() ([]*domain.Task, error) {
+	return r.queryTasks(`SELECT ` + taskColumns + ` FROM tasks ORDER BY created_at DESC`)
+}
+
+// ListTasks builds the WHERE/ORDER BY/LIMIT clauses dynamically so filtering,
+// sorting, and pagination are pushed down to SQLite instead of loading every
+// row and slicing in Go. It returns the page of tasks plus the total count of
+// tasks matching the filter (ignoring Limit/Offset).
+func (r *SQLiteProjectRepository) ListTasks(filter domain.TaskFilter) ([]*domain.Task, int, error) {
+	where := "WHERE 1=1"
+	args := []interface{}{}
+
+	if len(filter.ProjectIDs) > 0 {
+		where += " AND project_id IN (" + placeholders(len(filter.ProjectIDs)) + ")"
+		for _, id := range filter.ProjectIDs {
+			args = append(args, id)
+		}
+	}
+
+	if len(filter.Statuses) > 0 {
+		where += " AND status IN (" + placeholders(len(filter.Statuses)) + ")"
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+
+	if len(filter.Priorities) > 0 {
+		where += " AND priority IN (" + placeholders(len(filter.Priorities)) + ")"
+		for _, priority := range filter.Priorities {
+			args = append(args, priority)
+		}
+	}
+
+	if filter.AssignedTo != "" {
+		where += " AND assigned_to = ?"
+		args = append(args, filter.AssignedTo)
+	}
+
+	if filter.CreatedAfter != nil {
+		where += " AND created_at >= ?"
+		args = append(args, *filter.CreatedAfter)
+	}
+
+	if filter.CreatedBefore != nil {
+		where += " AND created_at <= ?"
+		args = append(args, *filter.CreatedBefore)
+	}
+
+	if filter.SearchText != "" {
+		where += " AND (title LIKE ? OR description LIKE ?)"
+		args = append(args, "%"+filter.SearchText+"%", "%"+filter.SearchText+"%")
+	}
+
+	var total int
+	if err := r.db.QueryRow("SELECT COUNT(*) FROM tasks "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	orderBy, ok := taskOrderByWhitelist[filter.OrderBy]
+	if !ok {
+		orderBy = taskOrderByWhitelist[""]
+	}
+
+	query := "SELECT " + taskColumns + " FROM tasks " + where + " ORDER BY " + orderBy
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	args = append(args, limit)
+	query += " LIMIT ?"
+
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += " OFFSET ?"
+	}
+
+	tasks, err := r.queryTasks(query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tasks, total, nil
+}
+
+func (r *SQLiteProjectRepository) UpdateTask(task *domain.Task) error {
+	query := `
+		UPDATE tasks
+		SET title = ?, description = ?, status = ?, priority = ?, assigned_to = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+
+	_, err := r.db.Exec(query, task.Title, task.Description, task.Status, task.Priority, task.AssignedTo, task.ID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) DeleteTask(id int) error {
+	_, err := r.db.Exec(`DELETE FROM tasks WHERE id = ?`, id)
+	return err
+}
+
+// Subtask operations
+
+func (r *SQLiteProjectRepository) CreateSubtask(task *domain.Task) error {
+	return r.CreateTask(task)
+}
+
+func (r *SQLiteProjectRepository) GetSubtasks(parentID int) ([]*domain.Task, error) {
+	return r.queryTasks(`SELECT `+taskColumns+` FROM tasks WHERE parent_task_id = ? ORDER BY created_at ASC`, parentID)
+}
+
+func (r *SQLiteProjectRepository) CompleteSubtask(id int, duration time.Duration) error {
+	query := `
+		UPDATE tasks
+		SET status = ?, duration_seconds = duration_seconds + ?, completed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+
+	_, err := r.db.Exec(query, domain.TaskStatusDone, int64(duration.Seconds()), id)
+	return err
+}
+
+func (r *SQLiteProjectRepository) AddTaskDuration(id int, duration time.Duration) error {
+	_, err := r.db.Exec(`UPDATE tasks SET duration_seconds = duration_seconds + ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`,
+		int64(duration.Seconds()), id)
+	return err
+}
+
+// Note operations
+
+func (r *SQLiteProjectRepository) CreateNote(note *domain.Note) error {
+	result, err := r.db.Exec(`INSERT INTO notes (project_id, task_id, content, created_at) VALUES (?, ?, ?, CURRENT_TIMESTAMP)`,
+		note.ProjectID, note.TaskID, note.Content)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	note.ID = int(id)
+
+	return r.db.QueryRow(`SELECT created_at FROM notes WHERE id = ?`, note.ID).Scan(&note.CreatedAt)
+}
+
+func (r *SQLiteProjectRepository) GetNotesByProject(projectID int) ([]*domain.Note, error) {
+	return r.queryNotes(`SELECT id, project_id, task_id, content, created_at FROM notes WHERE project_id = ? ORDER BY created_at DESC`, projectID)
+}
+
+func (r *SQLiteProjectRepository) GetNotesByTask(taskID int) ([]*domain.Note, error) {
+	return r.queryNotes(`SELECT id, project_id, task_id, content, created_at FROM notes WHERE task_id = ? ORDER BY created_at DESC`, taskID)
+}
+
+func (r *SQLiteProjectRepository) DeleteNote(id int) error {
+	_, err := r.db.Exec(`DELETE FROM notes WHERE id = ?`, id)
+	return err
+}
+
+// Requirement operations
+
+func (r *SQLiteProjectRepository) CreateRequirement(requirement *domain.Requirement) error {
+	query := `
+		INSERT INTO requirements (project_id, title, description, category, priority, status, acceptance_criteria, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)`
+
+	result, err := r.db.Exec(query, requirement.ProjectID, requirement.Title, requirement.Description,
+		requirement.Category, requirement.Priority, requirement.Status, joinCriteria(requirement.AcceptanceCriteria))
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	requirement.ID = int(id)
+
+	return r.db.QueryRow(`SELECT created_at FROM requirements WHERE id = ?`, requirement.ID).Scan(&requirement.CreatedAt)
+}
+
+func (r *SQLiteProjectRepository) GetRequirement(id int) (*domain.Requirement, error) {
+	requirement := &domain.Requirement{}
+	var criteria string
+	query := `SELECT id, project_id, title, description, category, priority, status, acceptance_criteria, created_at FROM requirements WHERE id = ?`
+
+	err := r.db.QueryRow(query, id).
+		Scan(&requirement.ID, &requirement.ProjectID, &requirement.Title, &requirement.Description,
+			&requirement.Category, &requirement.Priority, &requirement.Status, &criteria, &requirement.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	requirement.AcceptanceCriteria = splitCriteria(criteria)
+
+	return requirement, nil
+}
+
+func (r *SQLiteProjectRepository) GetRequirementsByProject(projectID int) ([]*domain.Requirement, error) {
+	query := `
+		SELECT id, project_id, title, description, category, priority, status, acceptance_criteria, created_at
+		FROM requirements WHERE project_id = ? ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requirements []*domain.Requirement
+	for rows.Next() {
+		requirement := &domain.Requirement{}
+		var criteria string
+		err := rows.Scan(&requirement.ID, &requirement.ProjectID, &requirement.Title, &requirement.Description,
+			&requirement.Category, &requirement.Priority, &requirement.Status, &criteria, &requirement.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		requirement.AcceptanceCriteria = splitCriteria(criteria)
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+func (r *SQLiteProjectRepository) UpdateRequirementStatus(id int, status string) error {
+	_, err := r.db.Exec(`UPDATE requirements SET status = ? WHERE id = ?`, status, id)
+	return err
+}
+
+func (r *SQLiteProjectRepository) LinkRequirementToTask(requirementID, taskID int) error {
+	_, err := r.db.Exec(`INSERT OR IGNORE INTO requirement_tasks (requirement_id, task_id) VALUES (?, ?)`, requirementID, taskID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) UnlinkRequirementFromTask(requirementID, taskID int) error {
+	_, err := r.db.Exec(`DELETE FROM requirement_tasks WHERE requirement_id = ? AND task_id = ?`, requirementID, taskID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) GetTasksByRequirement(requirementID int) ([]*domain.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (SELECT task_id FROM requirement_tasks WHERE requirement_id = ?)
+		ORDER BY created_at DESC`
+
+	return r.queryTasks(query, requirementID)
+}
+
+// Sprint operations
+
+func (r *SQLiteProjectRepository) CreateSprint(sprint *domain.Sprint) error {
+	query := `INSERT INTO sprints (project_id, name, start_date, end_date, goal, status) VALUES (?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Exec(query, sprint.ProjectID, sprint.Name, sprint.StartDate, sprint.EndDate, sprint.Goal, sprint.Status)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	sprint.ID = int(id)
+
+	return nil
+}
+
+func (r *SQLiteProjectRepository) GetSprint(id int) (*domain.Sprint, error) {
+	sprint := &domain.Sprint{}
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE id = ?`
+
+	err := r.db.QueryRow(query, id).
+		Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *SQLiteProjectRepository) GetActiveSprint(projectID int) (*domain.Sprint, error) {
+	sprint := &domain.Sprint{}
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE project_id = ? AND status = ? ORDER BY start_date DESC LIMIT 1`
+
+	err := r.db.QueryRow(query, projectID, domain.SprintStatusActive).
+		Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return sprint, nil
+}
+
+func (r *SQLiteProjectRepository) GetSprintsByProject(projectID int) ([]*domain.Sprint, error) {
+	query := `SELECT id, project_id, name, start_date, end_date, goal, status FROM sprints WHERE project_id = ? ORDER BY start_date DESC`
+
+	rows, err := r.db.Query(query, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sprints []*domain.Sprint
+	for rows.Next() {
+		sprint := &domain.Sprint{}
+		if err := rows.Scan(&sprint.ID, &sprint.ProjectID, &sprint.Name, &sprint.StartDate, &sprint.EndDate, &sprint.Goal, &sprint.Status); err != nil {
+			return nil, err
+		}
+		sprints = append(sprints, sprint)
+	}
+
+	return sprints, nil
+}
+
+func (r *SQLiteProjectRepository) UpdateSprintStatus(id int, status string) error {
+	_, err := r.db.Exec(`UPDATE sprints SET status = ? WHERE id = ?`, status, id)
+	return err
+}
+
+func (r *SQLiteProjectRepository) AssignTaskToSprint(sprintID, taskID int) error {
+	_, err := r.db.Exec(`INSERT OR IGNORE INTO sprint_tasks (sprint_id, task_id) VALUES (?, ?)`, sprintID, taskID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) RemoveTaskFromSprint(sprintID, taskID int) error {
+	_, err := r.db.Exec(`DELETE FROM sprint_tasks WHERE sprint_id = ? AND task_id = ?`, sprintID, taskID)
+	return err
+}
+
+func (r *SQLiteProjectRepository) GetSprintTasks(sprintID int) ([]*domain.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks
+		WHERE id IN (SELECT task_id FROM sprint_tasks WHERE sprint_id = ?)
+		ORDER BY created_at DESC`
+
+	return r.queryTasks(query, sprintID)
+}
+
+// queryTasks runs a task query and scans every row with scanTask.
+func (r *SQLiteProjectRepository) queryTasks(query string, args ...interface{}) ([]*domain.Task, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task := &domain.Task{}
+		if err := scanTask(rows, task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// queryNotes runs a note query and scans every row.
+func (r *SQLiteProjectRepository) queryNotes(query string, args ...interface{}) ([]*domain.Note, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []*domain.Note
+	for rows.Next() {
+		note := &domain.Note{}
+		if err := rows.Scan(&note.ID, &note.ProjectID, &note.TaskID, &note.Content, &note.CreatedAt); err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	return notes, nil
+}
+
+// placeholders returns a comma-separated list of n "?" SQLite placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+// joinCriteria and splitCriteria store AcceptanceCriteria as a single
+// comma-separated column, since SQLite has no native array type and this
+// repository otherwise avoids a separate join table for it.
+func joinCriteria(criteria []string) string {
+	return strings.Join(criteria, ",")
+}
+
+func splitCriteria(stored string) []string {
+	if stored == "" {
+		return nil
+	}
+	return strings.Split(stored, ",")
+}
@@ -3,6 +3,7 @@ package interfaces
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ai-studio/backend/domain"
 )
@@ -47,6 +48,42 @@ func (p *ProjectManagerApp) UpdateProjectStatus(id int, status string) error {
 	return p.projectService.UpdateProjectStatus(id, status)
 }
 
+// ListProjects returns projects matching the given filter, for dashboards that
+// present projects by group, favorite, or expiring-soon buckets.
+func (p *ProjectManagerApp) ListProjects(filter domain.ProjectFilter) ([]*domain.Project, error) {
+	return p.projectService.ListProjects(filter)
+}
+
+// SetProjectFavorite marks or unmarks a project as a favorite
+func (p *ProjectManagerApp) SetProjectFavorite(id int, favorite bool) error {
+	return p.projectService.SetProjectFavorite(id, favorite)
+}
+
+// AssignProjectGroup assigns a project to a group, or ungroups it when groupID is nil
+func (p *ProjectManagerApp) AssignProjectGroup(projectID int, groupID *int) error {
+	return p.projectService.AssignProjectGroup(projectID, groupID)
+}
+
+// CreateProjectGroup creates a new project group
+func (p *ProjectManagerApp) CreateProjectGroup(name, description string) (*domain.ProjectGroup, error) {
+	return p.projectService.CreateProjectGroup(name, description)
+}
+
+// GetProjectGroups returns all project groups
+func (p *ProjectManagerApp) GetProjectGroups() ([]*domain.ProjectGroup, error) {
+	return p.projectService.GetProjectGroups()
+}
+
+// GetProjectsByGroup returns all projects belonging to a group
+func (p *ProjectManagerApp) GetProjectsByGroup(groupID int) ([]*domain.Project, error) {
+	return p.projectService.GetProjectsByGroup(groupID)
+}
+
+// GetUngroupedProjects returns all projects not assigned to a group
+func (p *ProjectManagerApp) GetUngroupedProjects() ([]*domain.Project, error) {
+	return p.projectService.GetUngroupedProjects()
+}
+
 // Task Management Methods
 
 // GetAllTasks returns all tasks across all projects
@@ -79,6 +116,43 @@ func (p *ProjectManagerApp) UpdateTaskPriority(id int, priority string) error {
 	return p.projectService.UpdateTaskPriority(id, priority)
 }
 
+// CreateSubtask creates a subtask under an existing task
+func (p *ProjectManagerApp) CreateSubtask(parentTaskID int, title, description, priority string) (*domain.Task, error) {
+	return p.projectService.CreateSubtask(parentTaskID, title, description, priority)
+}
+
+// GetSubtasks returns the subtasks of a task
+func (p *ProjectManagerApp) GetSubtasks(parentID int) ([]*domain.Task, error) {
+	return p.projectService.GetSubtasks(parentID)
+}
+
+// LogTaskTime records time spent on a task, in seconds
+func (p *ProjectManagerApp) LogTaskTime(id int, durationSeconds int64) error {
+	return p.projectService.LogTaskTime(id, time.Duration(durationSeconds)*time.Second)
+}
+
+// Sprint Management Methods
+
+// GetActiveSprint returns the currently active sprint for a project, if any
+func (p *ProjectManagerApp) GetActiveSprint(projectID int) (*domain.Sprint, error) {
+	return p.projectService.GetActiveSprint(projectID)
+}
+
+// StartSprint creates and activates a new sprint for a project
+func (p *ProjectManagerApp) StartSprint(projectID int, name, goal string, startDate, endDate time.Time) (*domain.Sprint, error) {
+	return p.projectService.StartSprint(projectID, name, goal, startDate, endDate)
+}
+
+// CloseSprint marks a sprint as closed
+func (p *ProjectManagerApp) CloseSprint(id int) error {
+	return p.projectService.CloseSprint(id)
+}
+
+// GetSprintBurndown returns burndown and velocity statistics for a sprint
+func (p *ProjectManagerApp) GetSprintBurndown(sprintID int) (*domain.SprintStats, error) {
+	return p.projectService.GetSprintBurndown(sprintID)
+}
+
 // Note Management Methods
 
 // AddNote adds a note to a project or task
@@ -108,6 +182,34 @@ func (p *ProjectManagerApp) GetOverallStats() (*domain.OverallStats, error) {
 	return p.projectService.GetOverallStats()
 }
 
+// Requirement Management Methods
+
+// CreateRequirement creates a new requirement for a project
+func (p *ProjectManagerApp) CreateRequirement(projectID int, title, description, category, priority string, acceptanceCriteria []string) (*domain.Requirement, error) {
+	return p.projectService.CreateRequirement(projectID, title, description, category, priority, acceptanceCriteria)
+}
+
+// GetRequirementsByProject returns all requirements for a project
+func (p *ProjectManagerApp) GetRequirementsByProject(projectID int) ([]*domain.Requirement, error) {
+	return p.projectService.GetRequirementsByProject(projectID)
+}
+
+// LinkRequirementToTask links a requirement to a task that implements it
+func (p *ProjectManagerApp) LinkRequirementToTask(requirementID, taskID int) error {
+	return p.projectService.LinkRequirementToTask(requirementID, taskID)
+}
+
+// UpdateRequirementStatus updates a requirement's status
+func (p *ProjectManagerApp) UpdateRequirementStatus(id int, status string) error {
+	return p.projectService.UpdateRequirementStatus(id, status)
+}
+
+// GetRequirementCompletion returns the completion percentage of a requirement
+// based on the statuses of its linked tasks
+func (p *ProjectManagerApp) GetRequirementCompletion(requirementID int) (*domain.RequirementCompletion, error) {
+	return p.projectService.GetRequirementCompletion(requirementID)
+}
+
 // Utility Methods
 
 // GetTaskStatuses returns available task statuses
@@ -155,7 +257,7 @@ func (p *ProjectManagerApp) GetDashboardData() (*DashboardData, error) {
 		return nil, fmt.Errorf("failed to get projects: %w", err)
 	}
 
-	tasks, err := p.projectService.GetAllTasks()
+	recentTasks, _, err := p.projectService.ListTasks(domain.TaskFilter{Limit: 10, OrderBy: "updated_at DESC"})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tasks: %w", err)
 	}
@@ -167,22 +269,20 @@ func (p *ProjectManagerApp) GetDashboardData() (*DashboardData, error) {
 
 	return &DashboardData{
 		Projects:     projects,
-		RecentTasks:  getRecentTasks(tasks, 10),
+		RecentTasks:  recentTasks,
 		OverallStats: overallStats,
 	}, nil
 }
 
+// GetTasksPaged returns a filtered, sorted page of tasks plus the total count
+// of tasks matching the filter, for paginated task list views.
+func (p *ProjectManagerApp) GetTasksPaged(filter domain.TaskFilter) ([]*domain.Task, int, error) {
+	return p.projectService.ListTasks(filter)
+}
+
 // DashboardData represents data for the main dashboard
 type DashboardData struct {
 	Projects     []*domain.Project      `json:"projects"`
 	RecentTasks  []*domain.Task         `json:"recent_tasks"`
 	OverallStats *domain.OverallStats   `json:"overall_stats"`
 }
-
-// Helper function to get recent tasks (could be moved to service layer)
-func getRecentTasks(tasks []*domain.Task, limit int) []*domain.Task {
-	if len(tasks) <= limit {
-		return tasks
-	}
-	return tasks[:limit]
-}
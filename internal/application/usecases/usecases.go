@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ai-launcher/internal/domain/entities"
@@ -11,81 +12,274 @@ import (
 	"github.com/google/uuid"
 )
 
+// manifestDigestPrefix identifies a ref passed to LaunchToolUseCase.Execute
+// as a manifest digest rather than a configuration ID.
+const manifestDigestPrefix = "sha256:"
+
 // LaunchToolUseCase handles launching AI tool instances
 type LaunchToolUseCase struct {
-	configRepo   repositories.ConfigurationRepository
-	instanceRepo repositories.InstanceRepository
-	toolManager  services.ToolManager
-	logService   services.LogService
+	configRepo       repositories.ConfigurationRepository
+	instanceRepo     repositories.InstanceRepository
+	executionRepo    repositories.ExecutionRepository // optional; nil skips execution/task bookkeeping
+	toolManager      services.ToolManager
+	systemService    services.SystemService
+	manifestRegistry services.ManifestRegistry // optional; nil refuses digest refs
+	log              services.Logger
 }
 
-// NewLaunchToolUseCase creates a new use case for launching tools
+// NewLaunchToolUseCase creates a new use case for launching tools. log is
+// typically logService.Logger().Named("launch_tool"). manifestRegistry may
+// be nil, in which case Execute only accepts configuration IDs. executionRepo
+// may also be nil, in which case Execute still runs its preflight steps but
+// records no LaunchExecution/LaunchTask history.
 func NewLaunchToolUseCase(
 	configRepo repositories.ConfigurationRepository,
 	instanceRepo repositories.InstanceRepository,
+	executionRepo repositories.ExecutionRepository,
 	toolManager services.ToolManager,
-	logService services.LogService,
+	systemService services.SystemService,
+	manifestRegistry services.ManifestRegistry,
+	log services.Logger,
 ) *LaunchToolUseCase {
 	return &LaunchToolUseCase{
-		configRepo:   configRepo,
-		instanceRepo: instanceRepo,
-		toolManager:  toolManager,
-		logService:   logService,
+		configRepo:       configRepo,
+		instanceRepo:     instanceRepo,
+		executionRepo:    executionRepo,
+		toolManager:      toolManager,
+		systemService:    systemService,
+		manifestRegistry: manifestRegistry,
+		log:              log,
 	}
 }
 
-// Execute launches a tool with the given configuration
-func (uc *LaunchToolUseCase) Execute(ctx context.Context, configID string) (*entities.AIToolInstance, error) {
-	// Retrieve configuration
-	config, err := uc.configRepo.FindByID(ctx, configID)
+// Execute launches a tool given either a configuration ID or a manifest
+// digest ("sha256:..."). A digest ref is pulled and its signature verified
+// through the manifest registry before launch; Execute refuses to launch a
+// manifest whose signature is required but missing or invalid. The launch
+// itself runs as a LaunchExecution of four LaunchTasks (dependency check,
+// port bind, process start, health probe), mirroring the
+// replication_execution/replication_task pattern, so a UI can show the full
+// timeline instead of just a terminal Running/Error status.
+func (uc *LaunchToolUseCase) Execute(ctx context.Context, ref string) (*entities.AIToolInstance, error) {
+	config, err := uc.resolveConfig(ctx, ref)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find configuration: %w", err)
+		return nil, err
 	}
 
-	// Validate configuration
-	if err := uc.toolManager.ValidateConfig(*config); err != nil {
-		return nil, fmt.Errorf("invalid configuration: %w", err)
+	startTime := time.Now()
+	execution := entities.LaunchExecution{
+		ID:        uuid.New().String(),
+		ConfigID:  config.ID,
+		Status:    entities.ExecutionRunning,
+		Total:     4,
+		Trigger:   "manual",
+		StartTime: &startTime,
 	}
+	uc.persistExecution(ctx, execution)
 
-	// Check if port is available
-	if !uc.toolManager.IsPortAvailable(config.Port) {
-		return nil, fmt.Errorf("port %d is not available", config.Port)
-	}
+	instance, err := uc.runTasks(ctx, &execution, *config)
 
-	// Launch the tool
-	instance, err := uc.toolManager.Launch(ctx, *config)
+	endTime := time.Now()
+	execution.EndTime = &endTime
 	if err != nil {
-		uc.logService.WriteLog(config.ID, services.LogLevelError, fmt.Sprintf("Failed to launch: %s", err.Error()))
-		return nil, fmt.Errorf("failed to launch tool: %w", err)
+		execution.Status = entities.ExecutionFailed
+	} else {
+		execution.Status = entities.ExecutionSucceeded
+	}
+	uc.persistExecution(ctx, execution)
+
+	return instance, err
+}
+
+// runTasks runs Execute's four preflight steps as LaunchTasks against
+// execution, in order, stopping at the first that fails after retrying per
+// config.RestartPolicy.
+func (uc *LaunchToolUseCase) runTasks(ctx context.Context, execution *entities.LaunchExecution, config entities.Configuration) (*entities.AIToolInstance, error) {
+	sequence := 0
+
+	sequence++
+	if err := uc.runTask(ctx, execution, config, entities.TaskDependencyCheck, sequence, func() error {
+		status := uc.systemService.CheckDependencies(config.Type)
+		if !status.Available {
+			return fmt.Errorf("missing dependencies: %v", status.MissingDeps)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sequence++
+	if err := uc.runTask(ctx, execution, config, entities.TaskPortBind, sequence, func() error {
+		if err := uc.toolManager.ValidateConfig(config); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+		if !uc.toolManager.IsPortAvailable(config.Port) {
+			return fmt.Errorf("port %d is not available", config.Port)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var instance *entities.AIToolInstance
+	sequence++
+	if err := uc.runTask(ctx, execution, config, entities.TaskProcessStart, sequence, func() error {
+		launched, err := uc.toolManager.Launch(ctx, config)
+		if err != nil {
+			return fmt.Errorf("failed to launch tool: %w", err)
+		}
+		instance = launched
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	// Save instance state
 	if err := uc.instanceRepo.Save(ctx, *instance); err != nil {
-		uc.logService.WriteLog(instance.ID, services.LogLevelError, fmt.Sprintf("Failed to save instance state: %s", err.Error()))
+		uc.log.Error("failed to save instance state", "instance_id", instance.ID, "error", err.Error())
 		return instance, fmt.Errorf("failed to save instance state: %w", err)
 	}
 
-	uc.logService.WriteLog(instance.ID, services.LogLevelInfo, "Tool launched successfully")
+	// ToolManager.Launch already started asynchronous health monitoring in
+	// the background when config.HealthCheck is set; this task records
+	// that monitoring began rather than blocking Execute on its first
+	// probe result.
+	sequence++
+	uc.runTask(ctx, execution, config, entities.TaskHealthProbe, sequence, func() error {
+		return nil
+	})
+
+	uc.log.Info("tool launched successfully", "instance_id", instance.ID)
 	return instance, nil
 }
 
+// runTask runs fn as a LaunchTask of kind at position sequence within
+// execution, retrying per config.RestartPolicy (MaximumRetries attempts
+// beyond the first, waiting MinimumBackoff between attempts and doubling up
+// to MaximumBackoff), and rolls the outcome into execution's counters. A
+// MaximumRetries of 0 here means a single attempt, not unlimited retries.
+func (uc *LaunchToolUseCase) runTask(ctx context.Context, execution *entities.LaunchExecution, config entities.Configuration, kind entities.TaskKind, sequence int, fn func() error) error {
+	started := time.Now()
+	task := entities.LaunchTask{
+		ID:          uuid.New().String(),
+		ExecutionID: execution.ID,
+		Sequence:    sequence,
+		Kind:        kind,
+		Status:      entities.TaskRunning,
+		StartTime:   &started,
+	}
+	execution.InProgress++
+	uc.persistExecution(ctx, *execution)
+	uc.persistTask(ctx, task)
+
+	attempts := config.RestartPolicy.MaximumRetries + 1
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := config.RestartPolicy.MinimumBackoff
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			if config.RestartPolicy.MaximumBackoff > 0 && backoff < config.RestartPolicy.MaximumBackoff {
+				backoff *= 2
+				if backoff > config.RestartPolicy.MaximumBackoff {
+					backoff = config.RestartPolicy.MaximumBackoff
+				}
+			}
+		}
+		lastErr = fn()
+		if lastErr == nil {
+			break
+		}
+	}
+
+	ended := time.Now()
+	task.EndTime = &ended
+	execution.InProgress--
+	if lastErr != nil {
+		task.Status = entities.TaskFailed
+		task.Error = lastErr.Error()
+		execution.Failed++
+	} else {
+		task.Status = entities.TaskSucceeded
+		execution.Succeeded++
+	}
+	uc.persistExecution(ctx, *execution)
+	uc.persistTask(ctx, task)
+
+	return lastErr
+}
+
+// persistExecution saves execution if uc has an executionRepo configured,
+// logging rather than failing the launch on a persistence error: execution
+// history is best-effort bookkeeping, not load-bearing for the launch
+// itself.
+func (uc *LaunchToolUseCase) persistExecution(ctx context.Context, execution entities.LaunchExecution) {
+	if uc.executionRepo == nil {
+		return
+	}
+	if err := uc.executionRepo.SaveExecution(ctx, execution); err != nil {
+		uc.log.Error("failed to save launch execution", "execution_id", execution.ID, "error", err.Error())
+	}
+}
+
+// persistTask saves task if uc has an executionRepo configured; see
+// persistExecution for why a persistence error doesn't fail the launch.
+func (uc *LaunchToolUseCase) persistTask(ctx context.Context, task entities.LaunchTask) {
+	if uc.executionRepo == nil {
+		return
+	}
+	if err := uc.executionRepo.SaveTask(ctx, task); err != nil {
+		uc.log.Error("failed to save launch task", "task_id", task.ID, "error", err.Error())
+	}
+}
+
+// resolveConfig looks up the Configuration identified by ref, which is
+// either a plain configuration ID or a manifest digest.
+func (uc *LaunchToolUseCase) resolveConfig(ctx context.Context, ref string) (*entities.Configuration, error) {
+	if !strings.HasPrefix(ref, manifestDigestPrefix) {
+		config, err := uc.configRepo.FindByID(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find configuration: %w", err)
+		}
+		return config, nil
+	}
+
+	if uc.manifestRegistry == nil {
+		return nil, fmt.Errorf("manifest digest refs require a manifest registry, none configured")
+	}
+
+	m, err := uc.manifestRegistry.Pull(ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull manifest %s: %w", ref, err)
+	}
+
+	if err := uc.manifestRegistry.Verify(m); err != nil {
+		return nil, fmt.Errorf("refusing to launch unverified manifest %s: %w", ref, err)
+	}
+
+	return &m.Config, nil
+}
+
 // StopToolUseCase handles stopping AI tool instances
 type StopToolUseCase struct {
 	instanceRepo repositories.InstanceRepository
 	toolManager  services.ToolManager
-	logService   services.LogService
+	log          services.Logger
 }
 
-// NewStopToolUseCase creates a new use case for stopping tools
+// NewStopToolUseCase creates a new use case for stopping tools. log is
+// typically logService.Logger().Named("stop_tool").
 func NewStopToolUseCase(
 	instanceRepo repositories.InstanceRepository,
 	toolManager services.ToolManager,
-	logService services.LogService,
+	log services.Logger,
 ) *StopToolUseCase {
 	return &StopToolUseCase{
 		instanceRepo: instanceRepo,
 		toolManager:  toolManager,
-		logService:   logService,
+		log:          log,
 	}
 }
 
@@ -103,7 +297,7 @@ func (uc *StopToolUseCase) Execute(ctx context.Context, instanceID string) error
 
 	// Stop the tool
 	if err := uc.toolManager.Stop(ctx, instanceID); err != nil {
-		uc.logService.WriteLog(instanceID, services.LogLevelError, fmt.Sprintf("Failed to stop: %s", err.Error()))
+		uc.log.Error("failed to stop", "instance_id", instanceID, "error", err.Error())
 		return fmt.Errorf("failed to stop tool: %w", err)
 	}
 
@@ -111,13 +305,13 @@ func (uc *StopToolUseCase) Execute(ctx context.Context, instanceID string) error
 	instance.Status = entities.StatusStopped
 	now := time.Now()
 	instance.StoppedAt = &now
-	
+
 	if err := uc.instanceRepo.Update(ctx, *instance); err != nil {
-		uc.logService.WriteLog(instanceID, services.LogLevelError, fmt.Sprintf("Failed to update instance state: %s", err.Error()))
+		uc.log.Error("failed to update instance state", "instance_id", instanceID, "error", err.Error())
 		return fmt.Errorf("failed to update instance state: %w", err)
 	}
 
-	uc.logService.WriteLog(instanceID, services.LogLevelInfo, "Tool stopped successfully")
+	uc.log.Info("tool stopped successfully", "instance_id", instanceID)
 	return nil
 }
 
@@ -125,19 +319,20 @@ func (uc *StopToolUseCase) Execute(ctx context.Context, instanceID string) error
 type CreateConfigurationUseCase struct {
 	configRepo    repositories.ConfigurationRepository
 	systemService services.SystemService
-	logService    services.LogService
+	log           services.Logger
 }
 
-// NewCreateConfigurationUseCase creates a new use case for creating configurations
+// NewCreateConfigurationUseCase creates a new use case for creating
+// configurations. log is typically logService.Logger().Named("create_config").
 func NewCreateConfigurationUseCase(
 	configRepo repositories.ConfigurationRepository,
 	systemService services.SystemService,
-	logService services.LogService,
+	log services.Logger,
 ) *CreateConfigurationUseCase {
 	return &CreateConfigurationUseCase{
 		configRepo:    configRepo,
 		systemService: systemService,
-		logService:    logService,
+		log:           log,
 	}
 }
 
@@ -169,7 +364,7 @@ func (uc *CreateConfigurationUseCase) Execute(ctx context.Context, req CreateCon
 		return nil, fmt.Errorf("failed to save configuration: %w", err)
 	}
 
-	uc.logService.WriteLog(config.ID, services.LogLevelInfo, "Configuration created successfully")
+	uc.log.Info("configuration created successfully", "config_id", config.ID)
 	return &config, nil
 }
 
@@ -186,6 +381,176 @@ type CreateConfigRequest struct {
 	AutoStart      bool              `json:"auto_start"`
 }
 
+// CloneConfigurationUseCase handles cloning an existing Configuration into a
+// new one with selective overrides, mirroring `podman container clone`: the
+// clone gets a fresh ID and deep-copied Arguments/Environment (the source is
+// never mutated by editing the clone), and can optionally be launched
+// immediately and/or have its source destroyed once the clone is persisted.
+type CloneConfigurationUseCase struct {
+	configRepo    repositories.ConfigurationRepository
+	instanceRepo  repositories.InstanceRepository
+	toolManager   services.ToolManager
+	systemService services.SystemService
+	launchTool    *LaunchToolUseCase
+	stopTool      *StopToolUseCase
+	log           services.Logger
+}
+
+// NewCloneConfigurationUseCase creates a new use case for cloning
+// configurations. log is typically logService.Logger().Named("clone_config").
+func NewCloneConfigurationUseCase(
+	configRepo repositories.ConfigurationRepository,
+	instanceRepo repositories.InstanceRepository,
+	toolManager services.ToolManager,
+	systemService services.SystemService,
+	launchTool *LaunchToolUseCase,
+	stopTool *StopToolUseCase,
+	log services.Logger,
+) *CloneConfigurationUseCase {
+	return &CloneConfigurationUseCase{
+		configRepo:    configRepo,
+		instanceRepo:  instanceRepo,
+		toolManager:   toolManager,
+		systemService: systemService,
+		launchTool:    launchTool,
+		stopTool:      stopTool,
+		log:           log,
+	}
+}
+
+// CloneConfigRequest customizes the Configuration CloneConfigurationUseCase
+// produces. Name/Host/WorkingDir, left empty, are copied from the source
+// unchanged; Port, left zero, is copied from the source and reassigned only
+// if it collides with something already bound.
+type CloneConfigRequest struct {
+	Name             string            `json:"name"`
+	Port             int               `json:"port"`
+	Host             string            `json:"host"`
+	WorkingDir       string            `json:"working_dir"`
+	AppendArguments  []string          `json:"append_arguments"`  // appended after the source's Arguments
+	ReplaceArguments []string          `json:"replace_arguments"` // if non-nil, used in place of the source's Arguments
+	Environment      map[string]string `json:"environment"`       // merged over the source's Environment, overriding shared keys
+	Run              bool              `json:"run"`               // also launch the clone via LaunchToolUseCase
+	Destroy          bool              `json:"destroy"`           // stop (if running) and delete the source after the clone is persisted
+}
+
+// CloneConfigResult is the Configuration CloneConfigurationUseCase produced,
+// plus the instance it was launched as when CloneConfigRequest.Run was set.
+type CloneConfigResult struct {
+	Config   entities.Configuration   `json:"config"`
+	Instance *entities.AIToolInstance `json:"instance,omitempty"`
+}
+
+// Execute clones the Configuration identified by ref — a configuration ID,
+// or the ID of an instance currently running from one — applying req's
+// overrides to the copy.
+func (uc *CloneConfigurationUseCase) Execute(ctx context.Context, ref string, req CloneConfigRequest) (*CloneConfigResult, error) {
+	source, sourceInstance, err := uc.resolveSource(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := *source
+	clone.ID = uuid.New().String()
+	clone.Arguments = cloneStrings(source.Arguments)
+	clone.Environment = cloneEnvironment(source.Environment)
+	clone.CreatedAt = time.Now()
+	clone.UpdatedAt = clone.CreatedAt
+
+	if req.Name != "" {
+		clone.Name = req.Name
+	}
+	if req.Host != "" {
+		clone.Host = req.Host
+	}
+	if req.WorkingDir != "" {
+		clone.WorkingDir = req.WorkingDir
+	}
+	if req.ReplaceArguments != nil {
+		clone.Arguments = cloneStrings(req.ReplaceArguments)
+	} else if len(req.AppendArguments) > 0 {
+		clone.Arguments = append(clone.Arguments, req.AppendArguments...)
+	}
+	for key, value := range req.Environment {
+		clone.Environment[key] = value
+	}
+
+	if req.Port != 0 {
+		clone.Port = req.Port
+	} else if !uc.toolManager.IsPortAvailable(clone.Port) {
+		start, end := uc.systemService.GetRecommendedPorts(clone.Type)
+		port, err := uc.toolManager.FindAvailablePort(start, end)
+		if err != nil {
+			return nil, fmt.Errorf("source port %d is taken and no replacement is free: %w", clone.Port, err)
+		}
+		clone.Port = port
+	}
+
+	if err := uc.configRepo.Save(ctx, clone); err != nil {
+		return nil, fmt.Errorf("failed to save cloned configuration: %w", err)
+	}
+	uc.log.Info("configuration cloned", "source_id", source.ID, "clone_id", clone.ID)
+
+	result := &CloneConfigResult{Config: clone}
+
+	if req.Run {
+		instance, err := uc.launchTool.Execute(ctx, clone.ID)
+		if err != nil {
+			return result, fmt.Errorf("clone saved but failed to launch: %w", err)
+		}
+		result.Instance = instance
+	}
+
+	if req.Destroy {
+		if sourceInstance != nil && sourceInstance.IsRunning() {
+			if err := uc.stopTool.Execute(ctx, sourceInstance.ID); err != nil {
+				uc.log.Error("failed to stop source instance for destroy", "instance_id", sourceInstance.ID, "error", err.Error())
+			}
+		}
+		if err := uc.configRepo.Delete(ctx, source.ID); err != nil {
+			uc.log.Error("failed to delete source configuration for destroy", "config_id", source.ID, "error", err.Error())
+		}
+	}
+
+	return result, nil
+}
+
+// resolveSource looks up ref as a configuration ID first, falling back to
+// treating it as an instance ID (returning that instance alongside its
+// configuration) so Destroy can stop the right running process.
+func (uc *CloneConfigurationUseCase) resolveSource(ctx context.Context, ref string) (*entities.Configuration, *entities.AIToolInstance, error) {
+	if config, err := uc.configRepo.FindByID(ctx, ref); err == nil {
+		return config, nil, nil
+	}
+
+	instance, err := uc.instanceRepo.FindByID(ctx, ref)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find configuration or instance %s: %w", ref, err)
+	}
+	return &instance.Config, instance, nil
+}
+
+// cloneStrings returns an independent copy of s, never sharing its backing
+// array with the original.
+func cloneStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	out := make([]string, len(s))
+	copy(out, s)
+	return out
+}
+
+// cloneEnvironment returns an independent copy of env, never sharing the
+// original map.
+func cloneEnvironment(env map[string]string) map[string]string {
+	out := make(map[string]string, len(env))
+	for k, v := range env {
+		out[k] = v
+	}
+	return out
+}
+
 // ListInstancesUseCase handles listing tool instances
 type ListInstancesUseCase struct {
 	instanceRepo repositories.InstanceRepository
@@ -207,6 +572,98 @@ func (uc *ListInstancesUseCase) Execute(ctx context.Context) ([]entities.AIToolI
 	return instances, nil
 }
 
+// ListExecutionsUseCase handles listing LaunchExecutions.
+type ListExecutionsUseCase struct {
+	executionRepo repositories.ExecutionRepository
+}
+
+// NewListExecutionsUseCase creates a new use case for listing launch
+// executions.
+func NewListExecutionsUseCase(executionRepo repositories.ExecutionRepository) *ListExecutionsUseCase {
+	return &ListExecutionsUseCase{executionRepo: executionRepo}
+}
+
+// Execute lists every LaunchExecution, most recent first.
+func (uc *ListExecutionsUseCase) Execute(ctx context.Context) ([]entities.LaunchExecution, error) {
+	executions, err := uc.executionRepo.FindExecutions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launch executions: %w", err)
+	}
+	return executions, nil
+}
+
+// ExecutionDetail is a LaunchExecution together with its LaunchTasks, in
+// the order they ran.
+type ExecutionDetail struct {
+	Execution entities.LaunchExecution `json:"execution"`
+	Tasks     []entities.LaunchTask    `json:"tasks"`
+}
+
+// GetExecutionUseCase handles retrieving a single LaunchExecution's full
+// timeline, so a UI can show more than a terminal Running/Error status.
+type GetExecutionUseCase struct {
+	executionRepo repositories.ExecutionRepository
+}
+
+// NewGetExecutionUseCase creates a new use case for retrieving a launch
+// execution's timeline.
+func NewGetExecutionUseCase(executionRepo repositories.ExecutionRepository) *GetExecutionUseCase {
+	return &GetExecutionUseCase{executionRepo: executionRepo}
+}
+
+// Execute retrieves the LaunchExecution identified by id plus its tasks, in
+// the order they ran.
+func (uc *GetExecutionUseCase) Execute(ctx context.Context, id string) (*ExecutionDetail, error) {
+	execution, err := uc.executionRepo.FindExecutionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find launch execution: %w", err)
+	}
+
+	tasks, err := uc.executionRepo.FindTasksByExecution(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launch tasks: %w", err)
+	}
+
+	return &ExecutionDetail{Execution: *execution, Tasks: tasks}, nil
+}
+
+// StopExecutionUseCase handles marking an in-progress LaunchExecution as
+// stopped. LaunchToolUseCase.Execute currently runs its tasks synchronously
+// to completion, so this only reaches an execution that's still
+// ExecutionPending/ExecutionRunning in the repository (e.g. one whose
+// process was killed before it could be marked terminal) — it does not
+// interrupt a launch actively in flight.
+type StopExecutionUseCase struct {
+	executionRepo repositories.ExecutionRepository
+}
+
+// NewStopExecutionUseCase creates a new use case for stopping a launch
+// execution.
+func NewStopExecutionUseCase(executionRepo repositories.ExecutionRepository) *StopExecutionUseCase {
+	return &StopExecutionUseCase{executionRepo: executionRepo}
+}
+
+// Execute marks the LaunchExecution identified by id as stopped.
+func (uc *StopExecutionUseCase) Execute(ctx context.Context, id string) error {
+	execution, err := uc.executionRepo.FindExecutionByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find launch execution: %w", err)
+	}
+
+	if execution.Status != entities.ExecutionPending && execution.Status != entities.ExecutionRunning {
+		return fmt.Errorf("launch execution %s already finished with status %s", id, execution.Status)
+	}
+
+	now := time.Now()
+	execution.Status = entities.ExecutionStopped
+	execution.EndTime = &now
+
+	if err := uc.executionRepo.SaveExecution(ctx, *execution); err != nil {
+		return fmt.Errorf("failed to update launch execution: %w", err)
+	}
+	return nil
+}
+
 // GetSystemInfoUseCase handles retrieving system information
 type GetSystemInfoUseCase struct {
 	systemService services.SystemService
@@ -223,3 +680,492 @@ func NewGetSystemInfoUseCase(systemService services.SystemService) *GetSystemInf
 func (uc *GetSystemInfoUseCase) Execute() services.SystemInfo {
 	return uc.systemService.GetSystemInfo()
 }
+
+// GenerateServiceUnitUseCase handles generating an init-system unit
+// definition for a Configuration.
+type GenerateServiceUnitUseCase struct {
+	serviceUnitService services.ServiceUnitService
+}
+
+// NewGenerateServiceUnitUseCase creates a new use case for generating
+// service units.
+func NewGenerateServiceUnitUseCase(serviceUnitService services.ServiceUnitService) *GenerateServiceUnitUseCase {
+	return &GenerateServiceUnitUseCase{
+		serviceUnitService: serviceUnitService,
+	}
+}
+
+// Execute renders config as a unit definition per opts.
+func (uc *GenerateServiceUnitUseCase) Execute(config entities.Configuration, opts entities.ServiceUnitOptions) (string, error) {
+	return uc.serviceUnitService.Generate(config, opts)
+}
+
+// CreateGroupUseCase handles creating new InstanceGroups.
+type CreateGroupUseCase struct {
+	groupRepo repositories.GroupRepository
+	log       services.Logger
+}
+
+// NewCreateGroupUseCase creates a new use case for creating InstanceGroups.
+// log is typically logService.Logger().Named("create_group").
+func NewCreateGroupUseCase(groupRepo repositories.GroupRepository, log services.Logger) *CreateGroupUseCase {
+	return &CreateGroupUseCase{groupRepo: groupRepo, log: log}
+}
+
+// CreateGroupRequest represents a request to create a new InstanceGroup.
+type CreateGroupRequest struct {
+	Name      string              `json:"name"`
+	ConfigIDs []string            `json:"config_ids"`
+	DependsOn map[string][]string `json:"depends_on"`
+	SharedEnv map[string]string   `json:"shared_env"`
+}
+
+// Execute creates a new InstanceGroup.
+func (uc *CreateGroupUseCase) Execute(ctx context.Context, req CreateGroupRequest) (*entities.InstanceGroup, error) {
+	if _, err := topologicalSort(req.ConfigIDs, req.DependsOn); err != nil {
+		return nil, fmt.Errorf("invalid depends_on: %w", err)
+	}
+
+	group := entities.InstanceGroup{
+		ID:        uuid.New().String(),
+		Name:      req.Name,
+		ConfigIDs: req.ConfigIDs,
+		DependsOn: req.DependsOn,
+		SharedEnv: req.SharedEnv,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := uc.groupRepo.Save(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to save group: %w", err)
+	}
+
+	uc.log.Info("group created successfully", "group_id", group.ID)
+	return &group, nil
+}
+
+// ListGroupsUseCase handles listing InstanceGroups.
+type ListGroupsUseCase struct {
+	groupRepo repositories.GroupRepository
+}
+
+// NewListGroupsUseCase creates a new use case for listing InstanceGroups.
+func NewListGroupsUseCase(groupRepo repositories.GroupRepository) *ListGroupsUseCase {
+	return &ListGroupsUseCase{groupRepo: groupRepo}
+}
+
+// Execute lists every InstanceGroup.
+func (uc *ListGroupsUseCase) Execute(ctx context.Context) ([]entities.InstanceGroup, error) {
+	groups, err := uc.groupRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	return groups, nil
+}
+
+// groupHealthTimeout bounds how long LaunchGroupUseCase waits for a member
+// to report healthy before launching the members that depend on it.
+const groupHealthTimeout = 30 * time.Second
+
+// groupHealthPollInterval is how often LaunchGroupUseCase re-checks a
+// member's health while waiting for it within groupHealthTimeout.
+const groupHealthPollInterval = 500 * time.Millisecond
+
+// LaunchGroupUseCase handles launching every Configuration in an
+// InstanceGroup as a unit, in DependsOn order, mirroring Podman's pod
+// launch: a member only starts once everything it depends on is healthy,
+// and a failure anywhere rolls back everything already started.
+type LaunchGroupUseCase struct {
+	groupRepo   repositories.GroupRepository
+	configRepo  repositories.ConfigurationRepository
+	launchTool  *LaunchToolUseCase
+	stopTool    *StopToolUseCase
+	toolManager services.ToolManager
+	log         services.Logger
+}
+
+// NewLaunchGroupUseCase creates a new use case for launching InstanceGroups.
+// log is typically logService.Logger().Named("launch_group").
+func NewLaunchGroupUseCase(
+	groupRepo repositories.GroupRepository,
+	configRepo repositories.ConfigurationRepository,
+	launchTool *LaunchToolUseCase,
+	stopTool *StopToolUseCase,
+	toolManager services.ToolManager,
+	log services.Logger,
+) *LaunchGroupUseCase {
+	return &LaunchGroupUseCase{
+		groupRepo:   groupRepo,
+		configRepo:  configRepo,
+		launchTool:  launchTool,
+		stopTool:    stopTool,
+		toolManager: toolManager,
+		log:         log,
+	}
+}
+
+// Execute launches every member of the InstanceGroup identified by groupID,
+// topologically sorted by DependsOn, waiting for each member to become
+// healthy before launching the members that depend on it. If any member
+// fails to launch or become healthy, every member already started is
+// stopped in reverse order before Execute returns the error.
+func (uc *LaunchGroupUseCase) Execute(ctx context.Context, groupID string) ([]entities.AIToolInstance, error) {
+	group, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find group: %w", err)
+	}
+
+	order, err := topologicalSort(group.ConfigIDs, group.DependsOn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to order group %s for launch: %w", groupID, err)
+	}
+
+	var launched []entities.AIToolInstance
+	for _, configID := range order {
+		if err := uc.applySharedEnv(ctx, configID, group.SharedEnv); err != nil {
+			uc.rollback(ctx, launched)
+			return nil, fmt.Errorf("failed to prepare %s: %w", configID, err)
+		}
+
+		instance, err := uc.launchTool.Execute(ctx, configID)
+		if err != nil {
+			uc.rollback(ctx, launched)
+			return nil, fmt.Errorf("failed to launch %s: %w", configID, err)
+		}
+		launched = append(launched, *instance)
+
+		if err := uc.waitHealthy(ctx, instance); err != nil {
+			uc.rollback(ctx, launched)
+			return nil, fmt.Errorf("%s: %w", configID, err)
+		}
+	}
+
+	uc.log.Info("group launched successfully", "group_id", groupID, "members", len(launched))
+	return launched, nil
+}
+
+// applySharedEnv merges sharedEnv into configID's own Environment, keeping
+// the configuration's own values where a key appears in both, and persists
+// the result so the member launches with it.
+func (uc *LaunchGroupUseCase) applySharedEnv(ctx context.Context, configID string, sharedEnv map[string]string) error {
+	if len(sharedEnv) == 0 {
+		return nil
+	}
+
+	config, err := uc.configRepo.FindByID(ctx, configID)
+	if err != nil {
+		return fmt.Errorf("failed to find configuration: %w", err)
+	}
+
+	if config.Environment == nil {
+		config.Environment = make(map[string]string, len(sharedEnv))
+	}
+	for key, value := range sharedEnv {
+		if _, exists := config.Environment[key]; !exists {
+			config.Environment[key] = value
+		}
+	}
+
+	return uc.configRepo.Update(ctx, *config)
+}
+
+// waitHealthy polls instance's health until it reports healthy, reports
+// unhealthy/error, or groupHealthTimeout elapses. An instance with no
+// HealthCheck configured is considered healthy as soon as it launches.
+func (uc *LaunchGroupUseCase) waitHealthy(ctx context.Context, instance *entities.AIToolInstance) error {
+	if instance.Config.HealthCheck == nil {
+		return nil
+	}
+
+	deadline := time.Now().Add(groupHealthTimeout)
+	for {
+		health, err := uc.toolManager.GetHealth(ctx, instance.ID)
+		if err == nil {
+			switch health.Status {
+			case entities.StatusHealthy:
+				return nil
+			case entities.StatusUnhealthy, entities.StatusError:
+				return fmt.Errorf("instance %s is unhealthy", instance.ID)
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("instance %s did not become healthy within %s", instance.ID, groupHealthTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(groupHealthPollInterval):
+		}
+	}
+}
+
+// rollback stops every instance in launched, in reverse order, so
+// dependents are stopped before what they depend on.
+func (uc *LaunchGroupUseCase) rollback(ctx context.Context, launched []entities.AIToolInstance) {
+	for i := len(launched) - 1; i >= 0; i-- {
+		instance := launched[i]
+		if err := uc.stopTool.Execute(ctx, instance.ID); err != nil {
+			uc.log.Error("rollback: failed to stop group member", "instance_id", instance.ID, "error", err.Error())
+		}
+	}
+}
+
+// StopGroupUseCase handles stopping every running instance launched from an
+// InstanceGroup's members, in reverse DependsOn order so dependents stop
+// before what they depend on.
+type StopGroupUseCase struct {
+	groupRepo    repositories.GroupRepository
+	instanceRepo repositories.InstanceRepository
+	stopTool     *StopToolUseCase
+	log          services.Logger
+}
+
+// NewStopGroupUseCase creates a new use case for stopping InstanceGroups.
+// log is typically logService.Logger().Named("stop_group").
+func NewStopGroupUseCase(
+	groupRepo repositories.GroupRepository,
+	instanceRepo repositories.InstanceRepository,
+	stopTool *StopToolUseCase,
+	log services.Logger,
+) *StopGroupUseCase {
+	return &StopGroupUseCase{
+		groupRepo:    groupRepo,
+		instanceRepo: instanceRepo,
+		stopTool:     stopTool,
+		log:          log,
+	}
+}
+
+// Execute stops every running instance belonging to the InstanceGroup
+// identified by groupID.
+func (uc *StopGroupUseCase) Execute(ctx context.Context, groupID string) error {
+	group, err := uc.groupRepo.FindByID(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to find group: %w", err)
+	}
+
+	order, err := topologicalSort(group.ConfigIDs, group.DependsOn)
+	if err != nil {
+		return fmt.Errorf("failed to order group %s for stop: %w", groupID, err)
+	}
+
+	instances, err := uc.instanceRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	byConfig := make(map[string][]entities.AIToolInstance, len(order))
+	for _, instance := range instances {
+		byConfig[instance.Config.ID] = append(byConfig[instance.Config.ID], instance)
+	}
+
+	var lastErr error
+	for i := len(order) - 1; i >= 0; i-- {
+		for _, instance := range byConfig[order[i]] {
+			if !instance.IsRunning() {
+				continue
+			}
+			if err := uc.stopTool.Execute(ctx, instance.ID); err != nil {
+				uc.log.Error("failed to stop group member", "instance_id", instance.ID, "error", err.Error())
+				lastErr = err
+			}
+		}
+	}
+
+	return lastErr
+}
+
+// topologicalSort orders nodes so each node comes after everything listed
+// in dependsOn[node], detecting cycles. A node absent from dependsOn is
+// treated as having no dependencies. Traversal follows nodes' order in the
+// input slice, so the result is deterministic across runs.
+func topologicalSort(nodes []string, dependsOn map[string][]string) ([]string, error) {
+	const (
+		unvisited = 0
+		visiting  = 1
+		done      = 2
+	)
+	state := make(map[string]int, len(nodes))
+	order := make([]string, 0, len(nodes))
+
+	var visit func(node string) error
+	visit = func(node string) error {
+		switch state[node] {
+		case done:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle detected at %s", node)
+		}
+
+		state[node] = visiting
+		for _, dep := range dependsOn[node] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[node] = done
+		order = append(order, node)
+		return nil
+	}
+
+	for _, node := range nodes {
+		if err := visit(node); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// CheckpointInstanceUseCase captures a CheckpointData snapshot of a
+// running instance via ToolManager.Checkpoint, for later replay by
+// RestoreInstanceUseCase.
+type CheckpointInstanceUseCase struct {
+	instanceRepo   repositories.InstanceRepository
+	checkpointRepo repositories.CheckpointRepository
+	toolManager    services.ToolManager
+	log            services.Logger
+}
+
+// NewCheckpointInstanceUseCase creates a new use case for checkpointing
+// instances. log is typically logService.Logger().Named("checkpoint_instance").
+func NewCheckpointInstanceUseCase(
+	instanceRepo repositories.InstanceRepository,
+	checkpointRepo repositories.CheckpointRepository,
+	toolManager services.ToolManager,
+	log services.Logger,
+) *CheckpointInstanceUseCase {
+	return &CheckpointInstanceUseCase{
+		instanceRepo:   instanceRepo,
+		checkpointRepo: checkpointRepo,
+		toolManager:    toolManager,
+		log:            log,
+	}
+}
+
+// Execute captures and persists a checkpoint of instanceID's current state.
+func (uc *CheckpointInstanceUseCase) Execute(ctx context.Context, instanceID string) (*entities.CheckpointData, error) {
+	if _, err := uc.instanceRepo.FindByID(ctx, instanceID); err != nil {
+		return nil, fmt.Errorf("failed to find instance: %w", err)
+	}
+
+	checkpoint, err := uc.toolManager.Checkpoint(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to checkpoint instance: %w", err)
+	}
+
+	if err := uc.checkpointRepo.Save(ctx, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+
+	uc.log.Info("instance checkpointed", "instance_id", instanceID, "checkpoint_id", checkpoint.ID, "sequence", checkpoint.Sequence)
+	return &checkpoint, nil
+}
+
+// RestoreInstanceUseCase relaunches an instance from its most recent
+// checkpoint rather than a cold launch, replaying the checkpoint's resume
+// token against the freshly started process.
+type RestoreInstanceUseCase struct {
+	instanceRepo   repositories.InstanceRepository
+	checkpointRepo repositories.CheckpointRepository
+	toolManager    services.ToolManager
+	log            services.Logger
+}
+
+// NewRestoreInstanceUseCase creates a new use case for restoring instances.
+// log is typically logService.Logger().Named("restore_instance").
+func NewRestoreInstanceUseCase(
+	instanceRepo repositories.InstanceRepository,
+	checkpointRepo repositories.CheckpointRepository,
+	toolManager services.ToolManager,
+	log services.Logger,
+) *RestoreInstanceUseCase {
+	return &RestoreInstanceUseCase{
+		instanceRepo:   instanceRepo,
+		checkpointRepo: checkpointRepo,
+		toolManager:    toolManager,
+		log:            log,
+	}
+}
+
+// Execute restores instanceID from its latest checkpoint. Restoring
+// relaunches the process under a new instance ID; it does not resurrect
+// the checkpointed one.
+func (uc *RestoreInstanceUseCase) Execute(ctx context.Context, instanceID string) (*entities.AIToolInstance, error) {
+	checkpoint, err := uc.checkpointRepo.FindLatestByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find checkpoint: %w", err)
+	}
+
+	instance, err := uc.toolManager.Restore(ctx, *checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore instance: %w", err)
+	}
+
+	if err := uc.instanceRepo.Save(ctx, *instance); err != nil {
+		uc.log.Error("failed to save restored instance state", "instance_id", instance.ID, "error", err.Error())
+		return instance, fmt.Errorf("failed to save restored instance state: %w", err)
+	}
+
+	uc.log.Info("instance restored from checkpoint", "instance_id", instance.ID, "checkpoint_id", checkpoint.ID)
+	return instance, nil
+}
+
+// ListCheckpointsUseCase handles listing an instance's checkpoints.
+type ListCheckpointsUseCase struct {
+	checkpointRepo repositories.CheckpointRepository
+}
+
+// NewListCheckpointsUseCase creates a new use case for listing checkpoints.
+func NewListCheckpointsUseCase(checkpointRepo repositories.CheckpointRepository) *ListCheckpointsUseCase {
+	return &ListCheckpointsUseCase{checkpointRepo: checkpointRepo}
+}
+
+// Execute lists every checkpoint captured for instanceID, oldest first.
+func (uc *ListCheckpointsUseCase) Execute(ctx context.Context, instanceID string) ([]entities.CheckpointData, error) {
+	checkpoints, err := uc.checkpointRepo.FindByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
+
+// PruneCheckpointsUseCase deletes all but the keepN most recent
+// checkpoints for an instance, mirroring the cap impl.LogService applies
+// to its per-instance log buffer.
+type PruneCheckpointsUseCase struct {
+	checkpointRepo repositories.CheckpointRepository
+	log            services.Logger
+}
+
+// NewPruneCheckpointsUseCase creates a new use case for pruning
+// checkpoints. log is typically logService.Logger().Named("prune_checkpoints").
+func NewPruneCheckpointsUseCase(checkpointRepo repositories.CheckpointRepository, log services.Logger) *PruneCheckpointsUseCase {
+	return &PruneCheckpointsUseCase{checkpointRepo: checkpointRepo, log: log}
+}
+
+// Execute deletes instanceID's oldest checkpoints beyond the keepN most
+// recent. A keepN of 0 or less deletes every checkpoint.
+func (uc *PruneCheckpointsUseCase) Execute(ctx context.Context, instanceID string, keepN int) error {
+	checkpoints, err := uc.checkpointRepo.FindByInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("failed to list checkpoints: %w", err)
+	}
+	if keepN < 0 {
+		keepN = 0
+	}
+	if len(checkpoints) <= keepN {
+		return nil
+	}
+
+	var lastErr error
+	for _, checkpoint := range checkpoints[:len(checkpoints)-keepN] {
+		if err := uc.checkpointRepo.Delete(ctx, checkpoint.ID); err != nil {
+			uc.log.Error("failed to prune checkpoint", "checkpoint_id", checkpoint.ID, "error", err.Error())
+			lastErr = err
+		}
+	}
+	return lastErr
+}
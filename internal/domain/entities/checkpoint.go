@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// CheckpointData is a point-in-time snapshot of a running instance,
+// captured by CheckpointInstanceUseCase and replayed by
+// RestoreInstanceUseCase to resume it without a cold launch. It mirrors
+// Podman's checkpoint/restore workflow, minus the CRIU process-image dump:
+// instead of freezing memory pages, it captures enough domain state
+// (configuration, last PID, recent output) plus a tool-specific
+// "resume token" to reconstruct equivalent behavior after a fresh launch.
+type CheckpointData struct {
+	ID          string            `json:"id"`
+	InstanceID  string            `json:"instance_id"`
+	Config      Configuration     `json:"config"`                 // frozen snapshot of the instance's Configuration at checkpoint time
+	LastPID     int               `json:"last_pid"`               // 0 if the instance had already exited
+	Environment map[string]string `json:"environment"`            // Config.Environment overrides applied at launch; the inherited host environment is not captured
+	LogTail     []LogLine         `json:"log_tail"`               // trailing stdout/stderr captured for diagnostics
+	Sequence    int               `json:"sequence"`               // monotonic per-instance checkpoint counter
+	ResumeToken string            `json:"resume_token,omitempty"` // opaque; produced and consumed by the instance's services.ToolAdapter
+	CreatedAt   time.Time         `json:"created_at"`
+}
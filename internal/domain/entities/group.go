@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// InstanceGroup is a named set of Configurations meant to be launched and
+// stopped together, mirroring Podman's pod concept. DependsOn encodes a DAG
+// over ConfigIDs (each key's configuration starts only after the
+// configurations it lists have become healthy) so members with a real
+// startup order — e.g. "OpenWebUI depends on Ollama" — can be declared once
+// and started as a unit. SharedEnv is merged into each member's own
+// Environment before it launches, without overriding keys the member
+// already sets itself.
+type InstanceGroup struct {
+	ID            string              `json:"id"`
+	Name          string              `json:"name"`
+	ConfigIDs     []string            `json:"config_ids"`
+	DependsOn     map[string][]string `json:"depends_on"`
+	SharedEnv     map[string]string   `json:"shared_env"`
+	RestartPolicy RestartPolicy       `json:"restart_policy"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
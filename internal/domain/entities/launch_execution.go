@@ -0,0 +1,70 @@
+package entities
+
+import "time"
+
+// ExecutionStatus is the aggregate state of a LaunchExecution, rolled up
+// from the status of its LaunchTasks.
+type ExecutionStatus string
+
+const (
+	ExecutionPending   ExecutionStatus = "pending"
+	ExecutionRunning   ExecutionStatus = "running"
+	ExecutionSucceeded ExecutionStatus = "succeeded"
+	ExecutionFailed    ExecutionStatus = "failed"
+	ExecutionStopped   ExecutionStatus = "stopped"
+)
+
+// LaunchExecution records one run of LaunchToolUseCase against a
+// Configuration as a sequence of LaunchTasks, mirroring the
+// replication_execution/replication_task pattern: an execution is the
+// launch as a whole, tasks are its individual preflight steps, and the
+// three counters let a UI show progress without re-deriving it from the
+// task list.
+type LaunchExecution struct {
+	ID         string          `json:"id"`
+	ConfigID   string          `json:"config_id"`
+	Status     ExecutionStatus `json:"status"`
+	Total      int             `json:"total"`
+	Failed     int             `json:"failed"`
+	Succeeded  int             `json:"succeeded"`
+	InProgress int             `json:"in_progress"`
+	Trigger    string          `json:"trigger"` // e.g. "manual", "auto-start", "restart-policy"
+	StartTime  *time.Time      `json:"start_time"`
+	EndTime    *time.Time      `json:"end_time"`
+}
+
+// TaskKind identifies which preflight step a LaunchTask represents.
+type TaskKind string
+
+const (
+	TaskDependencyCheck TaskKind = "dependency-check"
+	TaskPortBind        TaskKind = "port-bind"
+	TaskProcessStart    TaskKind = "process-start"
+	TaskHealthProbe     TaskKind = "health-probe"
+)
+
+// TaskStatus is the state of a single LaunchTask.
+type TaskStatus string
+
+const (
+	TaskPending   TaskStatus = "pending"
+	TaskRunning   TaskStatus = "running"
+	TaskSucceeded TaskStatus = "succeeded"
+	TaskFailed    TaskStatus = "failed"
+)
+
+// LaunchTask is one preflight step of a LaunchExecution (check
+// dependencies, validate config and bind port, start process, or probe
+// health).
+// Sequence orders tasks within an execution, since StartTime/EndTime are
+// both nil until a task actually runs.
+type LaunchTask struct {
+	ID          string     `json:"id"`
+	ExecutionID string     `json:"execution_id"`
+	Sequence    int        `json:"sequence"`
+	Kind        TaskKind   `json:"kind"`
+	Status      TaskStatus `json:"status"`
+	StartTime   *time.Time `json:"start_time"`
+	EndTime     *time.Time `json:"end_time"`
+	Error       string     `json:"error,omitempty"`
+}
@@ -9,53 +9,182 @@ import (
 type ToolType string
 
 const (
-	ComfyUI           ToolType = "comfyui"
-	Automatic1111     ToolType = "automatic1111"
-	Ollama            ToolType = "ollama"
-	LMStudio          ToolType = "lmstudio"
-	TextGenWebUI      ToolType = "text-gen-webui"
-	StableDiffusion   ToolType = "stable-diffusion"
-	LocalAI           ToolType = "localai"
+	ComfyUI         ToolType = "comfyui"
+	Automatic1111   ToolType = "automatic1111"
+	Ollama          ToolType = "ollama"
+	LMStudio        ToolType = "lmstudio"
+	TextGenWebUI    ToolType = "text-gen-webui"
+	StableDiffusion ToolType = "stable-diffusion"
+	LocalAI         ToolType = "localai"
 )
 
 // InstanceStatus represents the current status of a tool instance
 type InstanceStatus string
 
 const (
-	StatusStopped  InstanceStatus = "stopped"
-	StatusStarting InstanceStatus = "starting"
-	StatusRunning  InstanceStatus = "running"
-	StatusStopping InstanceStatus = "stopping"
-	StatusError    InstanceStatus = "error"
+	StatusStopped    InstanceStatus = "stopped"
+	StatusStarting   InstanceStatus = "starting"
+	StatusRunning    InstanceStatus = "running"
+	StatusStopping   InstanceStatus = "stopping"
+	StatusError      InstanceStatus = "error"
+	StatusHealthy    InstanceStatus = "healthy"
+	StatusUnhealthy  InstanceStatus = "unhealthy"
+	StatusRestarting InstanceStatus = "restarting"
 )
 
+// RestartPolicyMode selects when ToolManager relaunches an instance after
+// its process exits, matching the semantics popularized by container
+// runtimes (podman/docker --restart).
+type RestartPolicyMode string
+
+const (
+	RestartNo            RestartPolicyMode = "no"
+	RestartOnFailure     RestartPolicyMode = "on-failure"
+	RestartAlways        RestartPolicyMode = "always"
+	RestartUnlessStopped RestartPolicyMode = "unless-stopped"
+)
+
+// RestartPolicy controls whether and how aggressively an instance is
+// relaunched after its process exits.
+type RestartPolicy struct {
+	Mode           RestartPolicyMode `json:"mode"`
+	MaximumRetries int               `json:"maximum_retries"` // 0 means unlimited
+	MinimumBackoff time.Duration     `json:"minimum_backoff"`
+	MaximumBackoff time.Duration     `json:"maximum_backoff"`
+	SuccessWindow  time.Duration     `json:"success_window"` // uptime required to reset the attempt counter
+}
+
+// HealthCheckType selects how a HealthCheck probes an instance.
+type HealthCheckType string
+
+const (
+	HealthCheckHTTP HealthCheckType = "http"
+	HealthCheckTCP  HealthCheckType = "tcp"
+	HealthCheckExec HealthCheckType = "exec"
+)
+
+// HealthCheck describes how to probe whether a tool instance is actually
+// serving, as opposed to merely having a running process.
+type HealthCheck struct {
+	Type        HealthCheckType `json:"type"`
+	Path        string          `json:"path,omitempty"`    // URL path for Type == HealthCheckHTTP
+	Command     []string        `json:"command,omitempty"` // argv for Type == HealthCheckExec
+	Interval    time.Duration   `json:"interval"`
+	Timeout     time.Duration   `json:"timeout"`
+	Retries     int             `json:"retries"`      // consecutive failures before Unhealthy
+	StartPeriod time.Duration   `json:"start_period"` // grace period before failures count
+}
+
+// HealthResult is the outcome of a single probe.
+type HealthResult struct {
+	Timestamp time.Time `json:"timestamp"`
+	Success   bool      `json:"success"`
+	Output    string    `json:"output,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// HealthState is an instance's current health status plus its recent probe
+// history, most recent last.
+type HealthState struct {
+	Status  InstanceStatus `json:"status"`
+	History []HealthResult `json:"history"`
+}
+
+// LogStream identifies which stream a LogLine was captured from.
+type LogStream string
+
+const (
+	LogStreamStdout LogStream = "stdout"
+	LogStreamStderr LogStream = "stderr"
+)
+
+// LogLine is a single line of output captured from an instance's process.
+type LogLine struct {
+	Timestamp time.Time `json:"timestamp"`
+	Stream    LogStream `json:"stream"`
+	Line      string    `json:"line"`
+}
+
+// LogStreamOptions controls which lines StreamLogs returns and whether it
+// keeps the channel open for new output, matching the semantics of
+// `podman logs`.
+type LogStreamOptions struct {
+	Follow bool      `json:"follow"`
+	Tail   int       `json:"tail"`            // 0 means no tail limit
+	Since  time.Time `json:"since,omitempty"` // zero value means no lower bound
+	Until  time.Time `json:"until,omitempty"` // zero value means no upper bound
+}
+
+// PortOwner identifies the process currently bound to a TCP port, as
+// reported by the host's connection table (e.g. /proc/net/tcp, netstat,
+// lsof) rather than a transient bind-probe.
+type PortOwner struct {
+	Port        int    `json:"port"`
+	PID         int    `json:"pid"`
+	ProcessName string `json:"process_name,omitempty"`
+}
+
+// PortConflict describes a mismatch found by ReconcilePorts between the PID
+// ToolManager believes owns an instance's port and the PID that actually
+// owns it.
+type PortConflict struct {
+	InstanceID  string     `json:"instance_id"`
+	ExpectedPID int        `json:"expected_pid"`
+	ActualOwner *PortOwner `json:"actual_owner,omitempty"` // nil means the port is not currently bound
+}
+
+// ServiceUnitFormat selects which init system's unit format
+// ServiceUnitService.Generate produces.
+type ServiceUnitFormat string
+
+const (
+	ServiceUnitSystemd ServiceUnitFormat = "systemd"
+	ServiceUnitLaunchd ServiceUnitFormat = "launchd"
+	ServiceUnitWindows ServiceUnitFormat = "windows"
+)
+
+// ServiceUnitOptions customizes the unit ServiceUnitService.Generate
+// produces for a Configuration.
+type ServiceUnitOptions struct {
+	Format      ServiceUnitFormat `json:"format"`
+	Description string            `json:"description,omitempty"`
+	User        string            `json:"user,omitempty"`    // systemd/launchd: run as this user instead of the caller
+	Restart     bool              `json:"restart,omitempty"` // systemd/launchd: keep running via the init system rather than ToolManager's own restart policy
+}
+
 // Configuration holds the configuration for an AI tool instance
 type Configuration struct {
-	ID           string            `json:"id"`
-	Name         string            `json:"name"`
-	Type         ToolType          `json:"type"`
-	ExecutablePath string          `json:"executable_path"`
-	WorkingDir   string            `json:"working_dir"`
-	Port         int               `json:"port"`
-	Host         string            `json:"host"`
-	Arguments    []string          `json:"arguments"`
-	Environment  map[string]string `json:"environment"`
-	AutoStart    bool              `json:"auto_start"`
-	CreatedAt    time.Time         `json:"created_at"`
-	UpdatedAt    time.Time         `json:"updated_at"`
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Type           ToolType          `json:"type"`
+	ExecutablePath string            `json:"executable_path"`
+	WorkingDir     string            `json:"working_dir"`
+	Port           int               `json:"port"`
+	Host           string            `json:"host"`
+	Arguments      []string          `json:"arguments"`
+	Environment    map[string]string `json:"environment"`
+	AutoStart      bool              `json:"auto_start"`
+	HealthCheck    *HealthCheck      `json:"health_check,omitempty"` // nil disables healthchecking
+	RestartPolicy  RestartPolicy     `json:"restart_policy"`
+	LogLevel       string            `json:"log_level,omitempty"` // "trace"/"debug"/"info"/"warn"/"error"; "" inherits the LogService's global minimum
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
 }
 
 // AIToolInstance represents a running instance of an AI tool
 type AIToolInstance struct {
-	ID          string         `json:"id"`
-	Config      Configuration  `json:"config"`
-	Process     *os.Process    `json:"-"`
-	Status      InstanceStatus `json:"status"`
-	PID         int            `json:"pid"`
-	StartedAt   *time.Time     `json:"started_at"`
-	StoppedAt   *time.Time     `json:"stopped_at"`
-	LastError   string         `json:"last_error"`
-	LogFilePath string         `json:"log_file_path"`
+	ID              string         `json:"id"`
+	Config          Configuration  `json:"config"`
+	Process         *os.Process    `json:"-"`
+	Status          InstanceStatus `json:"status"`
+	PID             int            `json:"pid"`
+	StartedAt       *time.Time     `json:"started_at"`
+	StoppedAt       *time.Time     `json:"stopped_at"`
+	ExitCode        *int           `json:"exit_code"`
+	LastError       string         `json:"last_error"`
+	LogFilePath     string         `json:"log_file_path"`
+	RestartAttempts int            `json:"restart_attempts"`
+	NextRestartAt   *time.Time     `json:"next_restart_at,omitempty"`
 }
 
 // IsRunning returns true if the instance is currently running
@@ -84,3 +213,16 @@ func (i *AIToolInstance) GetURL() string {
 	}
 	return protocol + "://" + i.Config.Host + ":" + string(rune(i.Config.Port))
 }
+
+// ConfigurationManifest pins a specific, content-addressed version of a
+// Configuration so it can be referenced by digest (e.g. "sha256:..."),
+// shared between machines, and verified before launch. Digest is computed
+// over Config's content by domain/manifest.Digest; ParentDigest optionally
+// links a manifest to the one it was derived from, so drift between an
+// edited configuration and its pinned ancestor can be detected.
+type ConfigurationManifest struct {
+	Digest       string        `json:"digest"`
+	Config       Configuration `json:"config"`
+	Signature    []byte        `json:"signature,omitempty"`
+	ParentDigest string        `json:"parent_digest,omitempty"`
+}
@@ -0,0 +1,76 @@
+// Package manifest treats a Configuration as a content-addressable
+// artifact: Digest computes a stable identity for its settings, independent
+// of bookkeeping fields like ID or timestamps, so two configurations with
+// identical settings always hash identically.
+package manifest
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// content is the subset of a Configuration that participates in
+// content-addressing. ID, CreatedAt, and UpdatedAt are identity/bookkeeping
+// fields assigned by the repository layer, not part of what a configuration
+// actually launches, so they're deliberately excluded from the digest.
+type content struct {
+	Name           string
+	Type           entities.ToolType
+	ExecutablePath string
+	WorkingDir     string
+	Port           int
+	Host           string
+	Arguments      []string
+	Environment    map[string]string
+	AutoStart      bool
+	HealthCheck    *entities.HealthCheck
+	RestartPolicy  entities.RestartPolicy
+}
+
+// Canonicalize renders config's content-addressed subset as deterministic
+// JSON: struct fields in a fixed declaration order and map keys sorted
+// alphabetically, both guarantees encoding/json already makes.
+func Canonicalize(config entities.Configuration) ([]byte, error) {
+	c := content{
+		Name:           config.Name,
+		Type:           config.Type,
+		ExecutablePath: config.ExecutablePath,
+		WorkingDir:     config.WorkingDir,
+		Port:           config.Port,
+		Host:           config.Host,
+		Arguments:      config.Arguments,
+		Environment:    config.Environment,
+		AutoStart:      config.AutoStart,
+		HealthCheck:    config.HealthCheck,
+		RestartPolicy:  config.RestartPolicy,
+	}
+	return json.Marshal(c)
+}
+
+// Digest returns config's content-addressed digest, formatted "sha256:<hex>"
+// to match the container ecosystem's convention.
+func Digest(config entities.Configuration) (string, error) {
+	canonical, err := Canonicalize(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to canonicalize configuration: %w", err)
+	}
+	sum := sha256.Sum256(canonical)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// VerifySignature reports an error unless m.Signature is a valid ed25519
+// signature over m.Digest by publicKey.
+func VerifySignature(publicKey ed25519.PublicKey, m entities.ConfigurationManifest) error {
+	if len(m.Signature) == 0 {
+		return fmt.Errorf("manifest %s has no signature", m.Digest)
+	}
+	if !ed25519.Verify(publicKey, []byte(m.Digest), m.Signature) {
+		return fmt.Errorf("manifest %s has an invalid signature", m.Digest)
+	}
+	return nil
+}
@@ -9,43 +9,111 @@ import (
 type ConfigurationRepository interface {
 	// Save stores a configuration
 	Save(ctx context.Context, config entities.Configuration) error
-	
+
 	// FindByID retrieves a configuration by ID
 	FindByID(ctx context.Context, id string) (*entities.Configuration, error)
-	
+
 	// FindByType retrieves all configurations of a specific type
 	FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.Configuration, error)
-	
+
 	// FindAll retrieves all configurations
 	FindAll(ctx context.Context) ([]entities.Configuration, error)
-	
+
 	// Delete removes a configuration
 	Delete(ctx context.Context, id string) error
-	
+
 	// Update modifies an existing configuration
 	Update(ctx context.Context, config entities.Configuration) error
 }
 
+// ExecutionRepository handles persistence of LaunchExecutions and their
+// LaunchTasks, giving the UI a full launch timeline instead of just a
+// terminal Running/Error status.
+type ExecutionRepository interface {
+	// SaveExecution stores a LaunchExecution, including on update (e.g. to
+	// roll up its counters as tasks complete).
+	SaveExecution(ctx context.Context, execution entities.LaunchExecution) error
+
+	// FindExecutionByID retrieves a LaunchExecution by ID.
+	FindExecutionByID(ctx context.Context, id string) (*entities.LaunchExecution, error)
+
+	// FindExecutions retrieves every LaunchExecution, most recent first.
+	FindExecutions(ctx context.Context) ([]entities.LaunchExecution, error)
+
+	// SaveTask stores a LaunchTask, including on update as it transitions
+	// between TaskPending/TaskRunning/TaskSucceeded/TaskFailed.
+	SaveTask(ctx context.Context, task entities.LaunchTask) error
+
+	// FindTasksByExecution retrieves every LaunchTask belonging to
+	// executionID, in the order they were created.
+	FindTasksByExecution(ctx context.Context, executionID string) ([]entities.LaunchTask, error)
+}
+
+// GroupRepository handles persistence of InstanceGroups — named sets of
+// Configurations launched and stopped together as a unit.
+type GroupRepository interface {
+	// Save stores an InstanceGroup
+	Save(ctx context.Context, group entities.InstanceGroup) error
+
+	// FindByID retrieves an InstanceGroup by ID
+	FindByID(ctx context.Context, id string) (*entities.InstanceGroup, error)
+
+	// FindAll retrieves all InstanceGroups
+	FindAll(ctx context.Context) ([]entities.InstanceGroup, error)
+
+	// Delete removes an InstanceGroup
+	Delete(ctx context.Context, id string) error
+
+	// Update modifies an existing InstanceGroup
+	Update(ctx context.Context, group entities.InstanceGroup) error
+}
+
+// CheckpointRepository handles persistence of CheckpointData snapshots,
+// mirroring InstanceRepository's shape but keyed by instance rather than
+// holding one live record per ID: an instance may accumulate many
+// checkpoints over its lifetime.
+type CheckpointRepository interface {
+	// Save stores a checkpoint.
+	Save(ctx context.Context, checkpoint entities.CheckpointData) error
+
+	// FindByID retrieves a checkpoint by ID.
+	FindByID(ctx context.Context, id string) (*entities.CheckpointData, error)
+
+	// FindByInstance retrieves every checkpoint captured for instanceID,
+	// oldest first.
+	FindByInstance(ctx context.Context, instanceID string) ([]entities.CheckpointData, error)
+
+	// FindLatestByInstance retrieves the most recently captured checkpoint
+	// for instanceID.
+	FindLatestByInstance(ctx context.Context, instanceID string) (*entities.CheckpointData, error)
+
+	// FindAll retrieves every checkpoint across all instances.
+	FindAll(ctx context.Context) ([]entities.CheckpointData, error)
+
+	// Delete removes a checkpoint.
+	Delete(ctx context.Context, id string) error
+}
+
 // InstanceRepository handles runtime instance management
 type InstanceRepository interface {
 	// Save stores an instance state
 	Save(ctx context.Context, instance entities.AIToolInstance) error
-	
+
 	// FindByID retrieves an instance by ID
 	FindByID(ctx context.Context, id string) (*entities.AIToolInstance, error)
-	
+
 	// FindRunning retrieves all running instances
 	FindRunning(ctx context.Context) ([]entities.AIToolInstance, error)
-	
+
 	// FindByType retrieves instances of a specific type
 	FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.AIToolInstance, error)
-	
+
 	// FindAll retrieves all instances
 	FindAll(ctx context.Context) ([]entities.AIToolInstance, error)
-	
+
 	// Delete removes an instance
 	Delete(ctx context.Context, id string) error
-	
+
 	// Update modifies an existing instance
 	Update(ctx context.Context, instance entities.AIToolInstance) error
 }
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"os/exec"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// ToolAdapter knows how to configure, launch, and health-check one kind of
+// AI tool (ComfyUI, Automatic1111, Ollama, ...). Built-in and third-party
+// tools alike implement this interface and register themselves into an
+// AdapterRegistry, so ToolManager never switches on entities.ToolType
+// itself.
+type ToolAdapter interface {
+	// Type returns the entities.ToolType this adapter handles.
+	Type() entities.ToolType
+
+	// DefaultConfig returns a starter Configuration for this tool, with a
+	// fresh ID and sensible defaults for port, arguments, and health check.
+	DefaultConfig() entities.Configuration
+
+	// Validate reports an error if config isn't launchable by this
+	// adapter, in addition to ToolManager's own generic checks.
+	Validate(config entities.Configuration) error
+
+	// BuildCommand constructs the *exec.Cmd that launches config. ctx
+	// governs the resulting process's lifetime, matching
+	// exec.CommandContext.
+	BuildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error)
+
+	// HealthCheck probes instance and reports an error unless it's
+	// healthy. Called on instance.Config.HealthCheck's Interval.
+	HealthCheck(ctx context.Context, instance *entities.AIToolInstance) error
+
+	// ParseLogLine extracts structured fields from one line of the tool's
+	// stdout/stderr, for tools with a recognizable log format.
+	// Implementations with nothing to extract may return a LogEntry with
+	// just Message set.
+	ParseLogLine(line string) LogEntry
+
+	// Checkpoint captures tool-specific state that can't be reconstructed
+	// from Configuration alone (e.g. Ollama's loaded model list, ComfyUI's
+	// queued prompts, Automatic1111's active model) as an opaque string to
+	// be replayed by Restore after the instance relaunches. Adapters with
+	// nothing worth capturing may return an empty string.
+	Checkpoint(ctx context.Context, instance *entities.AIToolInstance) (string, error)
+
+	// Restore replays token, as produced by Checkpoint, against instance
+	// once it has relaunched (e.g. re-pulling a previously loaded Ollama
+	// model). An empty token is a no-op.
+	Restore(ctx context.Context, instance *entities.AIToolInstance, token string) error
+}
+
+// AdapterRegistry looks up the ToolAdapter registered for a tool type.
+// Built-in adapters register themselves via init(), mirroring
+// database/sql driver registration: importing an adapter package for its
+// side effect is enough to make it available, without touching core
+// domain code.
+type AdapterRegistry interface {
+	// Register makes adapter available under adapter.Type(), overwriting
+	// any adapter previously registered for that type.
+	Register(adapter ToolAdapter)
+
+	// Get returns the adapter registered for toolType, if any.
+	Get(toolType entities.ToolType) (ToolAdapter, bool)
+
+	// List returns the tool types with a registered adapter.
+	List() []entities.ToolType
+}
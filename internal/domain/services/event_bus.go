@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// InstanceEventKind identifies what happened to an instance in an
+// InstanceEvent.
+type InstanceEventKind string
+
+const (
+	// InstanceLaunching fires once Launch has started a process, before the
+	// instance is confirmed running.
+	InstanceLaunching InstanceEventKind = "instance_launching"
+	// InstanceRunning fires when an instance transitions to Running or Healthy.
+	InstanceRunning InstanceEventKind = "instance_running"
+	// InstanceCrashed fires when an instance's process exits with an error,
+	// or its healthcheck fails past its retry budget.
+	InstanceCrashed InstanceEventKind = "instance_crashed"
+	// InstanceStopped fires when an instance exits cleanly or is stopped
+	// manually.
+	InstanceStopped InstanceEventKind = "instance_stopped"
+	// InstanceHealthDegraded fires when a healthcheck probe fails but hasn't
+	// yet exhausted its retry budget.
+	InstanceHealthDegraded InstanceEventKind = "instance_health_degraded"
+	// InstanceLog fires for every line an instance writes to stdout/stderr.
+	InstanceLog InstanceEventKind = "instance_log"
+)
+
+// InstanceEvent describes a single instance lifecycle transition or log
+// line, published to an InstanceEventBus.
+type InstanceEvent struct {
+	Kind       InstanceEventKind       `json:"kind"`
+	InstanceID string                  `json:"instance_id"`
+	ToolType   entities.ToolType       `json:"tool_type"`
+	Status     entities.InstanceStatus `json:"status,omitempty"`
+	Timestamp  time.Time               `json:"timestamp"`
+	Message    string                  `json:"message,omitempty"`
+	Log        *entities.LogLine       `json:"log,omitempty"`
+}
+
+// InstanceEventFilter narrows a Subscribe call to a subset of events. Zero
+// values match everything; a non-empty field restricts to that value/set.
+type InstanceEventFilter struct {
+	InstanceID string
+	ToolType   entities.ToolType
+	Kinds      []InstanceEventKind
+}
+
+// Match reports whether event satisfies f.
+func (f InstanceEventFilter) Match(event InstanceEvent) bool {
+	if f.InstanceID != "" && f.InstanceID != event.InstanceID {
+		return false
+	}
+	if f.ToolType != "" && f.ToolType != event.ToolType {
+		return false
+	}
+	if len(f.Kinds) > 0 {
+		found := false
+		for _, kind := range f.Kinds {
+			if kind == event.Kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// InstanceEventBus publishes instance lifecycle and log events to
+// subscribers, so callers (the Wails UI, the gRPC transport, future
+// replication workflows) can react to state changes without polling
+// ToolManager.List.
+type InstanceEventBus interface {
+	// Publish broadcasts event to every subscriber whose filter matches it.
+	Publish(event InstanceEvent)
+
+	// Subscribe returns a channel of events matching filter. The channel is
+	// pre-seeded with any buffered events matching filter so a subscriber
+	// that joins mid-lifecycle still sees recent history, then receives new
+	// events as they're published. The channel is closed when ctx is
+	// cancelled.
+	Subscribe(ctx context.Context, filter InstanceEventFilter) <-chan InstanceEvent
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"io"
+	"time"
+)
+
+// LogLevel is the severity of a structured log entry, ordered low to high
+// so a logger can filter by "at least this severity".
+type LogLevel int
+
+const (
+	LevelTrace LogLevel = iota
+	LevelDebug
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String renders level the way it appears in log output, e.g. "info".
+func (l LogLevel) String() string {
+	switch l {
+	case LevelTrace:
+		return "trace"
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// LogFormat selects how a Logger renders entries to its sink.
+type LogFormat string
+
+const (
+	// LogFormatJSON emits one JSON object per line, suitable for ingestion
+	// by downstream log tooling.
+	LogFormatJSON LogFormat = "json"
+	// LogFormatText emits colorized, human-readable lines, suitable for a
+	// terminal or the Wails dev console.
+	LogFormatText LogFormat = "text"
+)
+
+// Logger is a structured, leveled logger modeled on hashicorp/go-hclog:
+// messages carry a human-readable string plus alternating key/value pairs
+// rather than being pre-formatted, so sinks can render or filter on the
+// structure instead of parsing free text.
+type Logger interface {
+	Trace(msg string, kv ...interface{})
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+
+	// With returns a sub-logger that prepends kv to every message it logs,
+	// e.g. log.With("instance_id", id) so callers needn't repeat it.
+	With(kv ...interface{}) Logger
+
+	// Named returns a sub-logger whose Name is this logger's name joined to
+	// name with a dot, e.g. Named("launch") on a logger named "toolmanager"
+	// produces "toolmanager.launch".
+	Named(name string) Logger
+
+	// Name returns this logger's full dotted name.
+	Name() string
+}
+
+// LogEntry is a single structured log record, either freshly produced by a
+// Logger or read back via LogService.ReadLogs.
+type LogEntry struct {
+	Timestamp  time.Time              `json:"@timestamp"`
+	Level      LogLevel               `json:"@level"`
+	Message    string                 `json:"@message"`
+	Logger     string                 `json:"logger,omitempty"`
+	Caller     string                 `json:"caller,omitempty"`
+	InstanceID string                 `json:"instance_id,omitempty"`
+	ToolType   string                 `json:"tool_type,omitempty"`
+	Fields     map[string]interface{} `json:"fields,omitempty"`
+}
+
+// LogService vends Loggers and persists the entries they produce.
+type LogService interface {
+	// Logger returns the service's root logger. Callers typically call
+	// Named/With on it to scope a sub-logger to their component.
+	Logger() Logger
+
+	// WriteLogEntry persists entry directly, for producers (e.g.
+	// StreamProcessOutput) that already have a fully-formed LogEntry rather
+	// than a Logger's message-plus-key/values.
+	WriteLogEntry(entry LogEntry) error
+
+	// StreamProcessOutput scans stdout and stderr line-by-line, heuristically
+	// classifying each line's LogLevel from common prefixes ("ERROR",
+	// "[WARN]", ...) and coalescing multi-line Python tracebacks into a
+	// single entry, persisting each as a LogEntry tagged with instanceID via
+	// WriteLogEntry. It returns once both readers are exhausted, normally
+	// because the process they belong to has exited.
+	StreamProcessOutput(instanceID string, stdout, stderr io.Reader)
+
+	// SetInstanceLevel overrides the minimum level recorded for instanceID,
+	// independent of the service's global minimum, matching a
+	// Configuration's own LogLevel setting.
+	SetInstanceLevel(instanceID string, level LogLevel)
+
+	// ReadLogs returns the most recent persisted log entries for an
+	// instance, oldest first, that are at least minLevel. Passing LevelTrace
+	// applies no filtering.
+	ReadLogs(instanceID string, lines int, minLevel LogLevel) ([]LogEntry, error)
+
+	// RotateLogs rotates the on-disk log file for an instance.
+	RotateLogs(instanceID string) error
+
+	// CleanupLogs removes persisted log files older than olderThanDays.
+	CleanupLogs(olderThanDays int) error
+
+	// Tail streams LogEntries for an instance. With follow false, it reads
+	// the entries already flushed to the instance's log file once and
+	// closes the channel. With follow true, it also watches the log file
+	// for appends and streams each new entry as it's written, until the
+	// returned cancel func is called. The cancel func stops the watcher,
+	// releases its file handle, and closes the channel.
+	Tail(instanceID string, follow bool) (<-chan LogEntry, func() error, error)
+}
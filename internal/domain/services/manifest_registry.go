@@ -0,0 +1,24 @@
+package services
+
+import "github.com/ai-launcher/internal/domain/entities"
+
+// ManifestRegistry stores and resolves content-addressed
+// ConfigurationManifests, so a Configuration can be pinned, shared by
+// digest, and verified before launch.
+type ManifestRegistry interface {
+	// Push computes manifest.Config's digest, stores manifest under it, and
+	// returns the digest it was stored under.
+	Push(manifest entities.ConfigurationManifest) (string, error)
+
+	// Pull retrieves the manifest stored under digest.
+	Pull(digest string) (entities.ConfigurationManifest, error)
+
+	// Resolve looks up the digest a human-readable alias currently points
+	// to, e.g. Resolve("comfyui-prod") -> "sha256:...".
+	Resolve(alias string) (string, error)
+
+	// Verify checks manifest's signature against the registry's trusted
+	// key and signing policy, returning an error if a signature is
+	// required but missing or invalid.
+	Verify(manifest entities.ConfigurationManifest) error
+}
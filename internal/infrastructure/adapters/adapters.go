@@ -0,0 +1,13 @@
+// Package adapters hosts the process-wide tool adapter registry, plus
+// small probing helpers shared by individual adapter packages (comfyui,
+// automatic1111, ollama, ...). Those packages register themselves into
+// Default via init(), mirroring database/sql driver registration:
+// importing an adapter package for its side effect is enough to make it
+// available, without touching core domain code.
+package adapters
+
+import "github.com/ai-launcher/internal/infrastructure/services/impl"
+
+// Default is the process-wide adapter registry. container.NewContainer
+// hands it to ToolManager as its services.AdapterRegistry.
+var Default = impl.NewAdapterRegistry()
@@ -0,0 +1,132 @@
+// Package automatic1111 is the built-in services.ToolAdapter for the
+// AUTOMATIC1111 Stable Diffusion WebUI.
+package automatic1111
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/infrastructure/adapters"
+	"github.com/google/uuid"
+)
+
+func init() {
+	adapters.Default.Register(Adapter{})
+}
+
+// Adapter launches and health-checks Automatic1111 instances.
+type Adapter struct{}
+
+// Type implements services.ToolAdapter.
+func (Adapter) Type() entities.ToolType { return entities.Automatic1111 }
+
+// DefaultConfig implements services.ToolAdapter.
+func (Adapter) DefaultConfig() entities.Configuration {
+	now := time.Now()
+	return entities.Configuration{
+		ID:          uuid.New().String(),
+		Type:        entities.Automatic1111,
+		Name:        "Automatic1111 Instance",
+		Host:        "127.0.0.1",
+		Port:        7860,
+		Arguments:   []string{"--listen", "--port", "7860"},
+		Environment: make(map[string]string),
+		HealthCheck: &entities.HealthCheck{
+			Type:        entities.HealthCheckHTTP,
+			Path:        "/sdapi/v1/sd-models",
+			Interval:    10 * time.Second,
+			Timeout:     5 * time.Second,
+			Retries:     3,
+			StartPeriod: 15 * time.Second,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate implements services.ToolAdapter.
+func (Adapter) Validate(config entities.Configuration) error {
+	return nil
+}
+
+// BuildCommand implements services.ToolAdapter.
+func (Adapter) BuildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error) {
+	args := config.Arguments
+	if len(args) == 0 {
+		args = []string{"--listen", "--port", strconv.Itoa(config.Port)}
+	}
+	cmd := exec.CommandContext(ctx, config.ExecutablePath, args...)
+	cmd.Dir = config.WorkingDir
+	return cmd, nil
+}
+
+// HealthCheck implements services.ToolAdapter by running the generic
+// probe against instance.Config.HealthCheck.
+func (Adapter) HealthCheck(ctx context.Context, instance *entities.AIToolInstance) error {
+	return adapters.Probe(ctx, instance.Config)
+}
+
+// ParseLogLine implements services.ToolAdapter. Automatic1111's stdout has
+// no recognizable structure, so only Message is populated.
+func (Adapter) ParseLogLine(line string) services.LogEntry {
+	return services.LogEntry{Message: line}
+}
+
+// Checkpoint implements services.ToolAdapter by capturing the instance's
+// active model, so Restore can select it again after a relaunch.
+func (Adapter) Checkpoint(ctx context.Context, instance *entities.AIToolInstance) (string, error) {
+	url := fmt.Sprintf("http://%s:%d/sdapi/v1/options", instance.Config.Host, instance.Config.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var options struct {
+		SDModelCheckpoint string `json:"sd_model_checkpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&options); err != nil {
+		return "", err
+	}
+	return options.SDModelCheckpoint, nil
+}
+
+// Restore implements services.ToolAdapter by re-selecting token, as
+// produced by Checkpoint, as the relaunched instance's active model.
+func (Adapter) Restore(ctx context.Context, instance *entities.AIToolInstance, token string) error {
+	if token == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(map[string]string{"sd_model_checkpoint": token})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("http://%s:%d/sdapi/v1/options", instance.Config.Host, instance.Config.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to restore active model: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,125 @@
+// Package comfyui is the built-in services.ToolAdapter for ComfyUI.
+package comfyui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/infrastructure/adapters"
+	"github.com/google/uuid"
+)
+
+func init() {
+	adapters.Default.Register(Adapter{})
+}
+
+// Adapter launches and health-checks ComfyUI instances.
+type Adapter struct{}
+
+// Type implements services.ToolAdapter.
+func (Adapter) Type() entities.ToolType { return entities.ComfyUI }
+
+// DefaultConfig implements services.ToolAdapter.
+func (Adapter) DefaultConfig() entities.Configuration {
+	now := time.Now()
+	return entities.Configuration{
+		ID:          uuid.New().String(),
+		Type:        entities.ComfyUI,
+		Name:        "ComfyUI Instance",
+		Host:        "127.0.0.1",
+		Port:        8188,
+		Arguments:   []string{"--listen", "127.0.0.1", "--port", "8188"},
+		Environment: make(map[string]string),
+		HealthCheck: &entities.HealthCheck{
+			Type:        entities.HealthCheckHTTP,
+			Path:        "/system_stats",
+			Interval:    10 * time.Second,
+			Timeout:     5 * time.Second,
+			Retries:     3,
+			StartPeriod: 15 * time.Second,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate implements services.ToolAdapter. ComfyUI has no constraints
+// beyond ToolManager's generic checks.
+func (Adapter) Validate(config entities.Configuration) error {
+	return nil
+}
+
+// BuildCommand implements services.ToolAdapter.
+func (Adapter) BuildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error) {
+	args := config.Arguments
+	if len(args) == 0 {
+		args = []string{"--listen", config.Host, "--port", strconv.Itoa(config.Port)}
+	}
+	cmd := exec.CommandContext(ctx, config.ExecutablePath, args...)
+	cmd.Dir = config.WorkingDir
+	return cmd, nil
+}
+
+// HealthCheck implements services.ToolAdapter by running the generic
+// probe against instance.Config.HealthCheck.
+func (Adapter) HealthCheck(ctx context.Context, instance *entities.AIToolInstance) error {
+	return adapters.Probe(ctx, instance.Config)
+}
+
+// ParseLogLine implements services.ToolAdapter. ComfyUI's stdout has no
+// recognizable structure, so only Message is populated.
+func (Adapter) ParseLogLine(line string) services.LogEntry {
+	return services.LogEntry{Message: line}
+}
+
+// Checkpoint implements services.ToolAdapter by exporting the instance's
+// queued prompts, so Restore can requeue them after a relaunch.
+func (Adapter) Checkpoint(ctx context.Context, instance *entities.AIToolInstance) (string, error) {
+	url := fmt.Sprintf("http://%s:%d/prompt", instance.Config.Host, instance.Config.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// Restore implements services.ToolAdapter by resubmitting token, as
+// produced by Checkpoint, to the relaunched instance's prompt queue.
+func (Adapter) Restore(ctx context.Context, instance *entities.AIToolInstance, token string) error {
+	if token == "" {
+		return nil
+	}
+
+	url := fmt.Sprintf("http://%s:%d/prompt", instance.Config.Host, instance.Config.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(token))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to requeue checkpointed prompt: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
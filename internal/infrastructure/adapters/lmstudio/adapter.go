@@ -0,0 +1,76 @@
+// Package lmstudio is the built-in services.ToolAdapter for LM Studio.
+package lmstudio
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/infrastructure/adapters"
+	"github.com/google/uuid"
+)
+
+func init() {
+	adapters.Default.Register(Adapter{})
+}
+
+// Adapter launches LM Studio instances. LM Studio has no well-known
+// health-check endpoint, so DefaultConfig leaves HealthCheck nil.
+type Adapter struct{}
+
+// Type implements services.ToolAdapter.
+func (Adapter) Type() entities.ToolType { return entities.LMStudio }
+
+// DefaultConfig implements services.ToolAdapter.
+func (Adapter) DefaultConfig() entities.Configuration {
+	now := time.Now()
+	return entities.Configuration{
+		ID:          uuid.New().String(),
+		Type:        entities.LMStudio,
+		Name:        "LM Studio Instance",
+		Host:        "127.0.0.1",
+		Port:        1234,
+		Arguments:   []string{},
+		Environment: make(map[string]string),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Validate implements services.ToolAdapter.
+func (Adapter) Validate(config entities.Configuration) error {
+	return nil
+}
+
+// BuildCommand implements services.ToolAdapter.
+func (Adapter) BuildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error) {
+	cmd := exec.CommandContext(ctx, config.ExecutablePath, config.Arguments...)
+	cmd.Dir = config.WorkingDir
+	return cmd, nil
+}
+
+// HealthCheck implements services.ToolAdapter by running the generic
+// probe against instance.Config.HealthCheck, if one was configured.
+func (Adapter) HealthCheck(ctx context.Context, instance *entities.AIToolInstance) error {
+	return adapters.Probe(ctx, instance.Config)
+}
+
+// ParseLogLine implements services.ToolAdapter. LM Studio's stdout has no
+// recognizable structure, so only Message is populated.
+func (Adapter) ParseLogLine(line string) services.LogEntry {
+	return services.LogEntry{Message: line}
+}
+
+// Checkpoint implements services.ToolAdapter. LM Studio has no known API
+// for exporting loaded-model state, so there is nothing to capture.
+func (Adapter) Checkpoint(ctx context.Context, instance *entities.AIToolInstance) (string, error) {
+	return "", nil
+}
+
+// Restore implements services.ToolAdapter. There is no captured state to
+// replay; see Checkpoint.
+func (Adapter) Restore(ctx context.Context, instance *entities.AIToolInstance, token string) error {
+	return nil
+}
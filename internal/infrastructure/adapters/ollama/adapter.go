@@ -0,0 +1,142 @@
+// Package ollama is the built-in services.ToolAdapter for Ollama.
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/infrastructure/adapters"
+	"github.com/google/uuid"
+)
+
+func init() {
+	adapters.Default.Register(Adapter{})
+}
+
+// Adapter launches and health-checks Ollama instances.
+type Adapter struct{}
+
+// Type implements services.ToolAdapter.
+func (Adapter) Type() entities.ToolType { return entities.Ollama }
+
+// DefaultConfig implements services.ToolAdapter.
+func (Adapter) DefaultConfig() entities.Configuration {
+	now := time.Now()
+	return entities.Configuration{
+		ID:          uuid.New().String(),
+		Type:        entities.Ollama,
+		Name:        "Ollama Instance",
+		Host:        "127.0.0.1",
+		Port:        11434,
+		Arguments:   []string{"serve"},
+		Environment: make(map[string]string),
+		HealthCheck: &entities.HealthCheck{
+			Type:        entities.HealthCheckHTTP,
+			Path:        "/api/tags",
+			Interval:    10 * time.Second,
+			Timeout:     5 * time.Second,
+			Retries:     3,
+			StartPeriod: 15 * time.Second,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate implements services.ToolAdapter.
+func (Adapter) Validate(config entities.Configuration) error {
+	return nil
+}
+
+// BuildCommand implements services.ToolAdapter.
+func (Adapter) BuildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error) {
+	args := config.Arguments
+	if len(args) == 0 {
+		args = []string{"serve"}
+	}
+	cmd := exec.CommandContext(ctx, config.ExecutablePath, args...)
+	cmd.Dir = config.WorkingDir
+	return cmd, nil
+}
+
+// HealthCheck implements services.ToolAdapter by running the generic
+// probe against instance.Config.HealthCheck.
+func (Adapter) HealthCheck(ctx context.Context, instance *entities.AIToolInstance) error {
+	return adapters.Probe(ctx, instance.Config)
+}
+
+// ParseLogLine implements services.ToolAdapter. Ollama's stdout has no
+// recognizable structure, so only Message is populated.
+func (Adapter) ParseLogLine(line string) services.LogEntry {
+	return services.LogEntry{Message: line}
+}
+
+// Checkpoint implements services.ToolAdapter by capturing the instance's
+// currently loaded model list via Ollama's tags API, so Restore can
+// re-pull them after a relaunch.
+func (Adapter) Checkpoint(ctx context.Context, instance *entities.AIToolInstance) (string, error) {
+	url := fmt.Sprintf("http://%s:%d/api/tags", instance.Config.Host, instance.Config.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// Restore implements services.ToolAdapter by re-pulling every model named
+// in token, as produced by Checkpoint, re-priming the relaunched
+// instance's model cache.
+func (Adapter) Restore(ctx context.Context, instance *entities.AIToolInstance, token string) error {
+	if token == "" {
+		return nil
+	}
+
+	var tags struct {
+		Models []struct {
+			Name string `json:"name"`
+		} `json:"models"`
+	}
+	if err := json.Unmarshal([]byte(token), &tags); err != nil {
+		return fmt.Errorf("failed to decode checkpointed model list: %w", err)
+	}
+
+	url := fmt.Sprintf("http://%s:%d/api/pull", instance.Config.Host, instance.Config.Port)
+	for _, model := range tags.Models {
+		payload, err := json.Marshal(map[string]string{"name": model.Name})
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to re-pull model %s: %w", model.Name, err)
+		}
+		resp.Body.Close()
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// ProbeHTTP issues a GET to http://host:port/path and reports an error
+// unless it returns a non-error status code.
+func ProbeHTTP(ctx context.Context, host string, port int, path string) error {
+	url := fmt.Sprintf("http://%s:%d%s", host, port, path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unhealthy status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ProbeTCP reports an error unless a TCP connection to host:port succeeds.
+func ProbeTCP(ctx context.Context, host string, port int) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// ProbeExec runs command and reports an error unless it exits zero.
+func ProbeExec(ctx context.Context, command []string) error {
+	if len(command) == 0 {
+		return fmt.Errorf("exec healthcheck has no command configured")
+	}
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	return cmd.Run()
+}
+
+// Probe runs config.HealthCheck's configured probe once, dispatching on
+// its Type. It's the generic check an adapter can delegate to when it has
+// no tool-specific health signal beyond what entities.HealthCheck already
+// describes.
+func Probe(ctx context.Context, config entities.Configuration) error {
+	hc := config.HealthCheck
+	if hc == nil {
+		return fmt.Errorf("no health check configured")
+	}
+
+	switch hc.Type {
+	case entities.HealthCheckHTTP:
+		return ProbeHTTP(ctx, config.Host, config.Port, hc.Path)
+	case entities.HealthCheckTCP:
+		return ProbeTCP(ctx, config.Host, config.Port)
+	case entities.HealthCheckExec:
+		return ProbeExec(ctx, hc.Command)
+	default:
+		return fmt.Errorf("unsupported healthcheck type: %s", hc.Type)
+	}
+}
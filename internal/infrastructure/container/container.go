@@ -1,81 +1,423 @@
 package container
 
 import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
 	"github.com/ai-launcher/internal/application/usecases"
+	"github.com/ai-launcher/internal/domain/entities"
 	"github.com/ai-launcher/internal/domain/repositories"
 	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/infrastructure/adapters"
+	"github.com/ai-launcher/internal/infrastructure/manifest"
 	"github.com/ai-launcher/internal/infrastructure/repositories/memory"
+	sqlrepo "github.com/ai-launcher/internal/infrastructure/repositories/sql"
 	"github.com/ai-launcher/internal/infrastructure/services/impl"
 	"github.com/ai-launcher/internal/interfaces/wails"
+
+	// Blank-imported for their init() side effect: each registers its
+	// services.ToolAdapter into adapters.Default. Third-party adapters can
+	// be added the same way, without touching this file.
+	_ "github.com/ai-launcher/internal/infrastructure/adapters/automatic1111"
+	_ "github.com/ai-launcher/internal/infrastructure/adapters/comfyui"
+	_ "github.com/ai-launcher/internal/infrastructure/adapters/lmstudio"
+	_ "github.com/ai-launcher/internal/infrastructure/adapters/ollama"
+	_ "github.com/ai-launcher/internal/infrastructure/adapters/textgenwebui"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 // Container holds all dependencies
 type Container struct {
 	// Repositories
-	ConfigRepo   repositories.ConfigurationRepository
-	InstanceRepo repositories.InstanceRepository
+	ConfigRepo     repositories.ConfigurationRepository
+	InstanceRepo   repositories.InstanceRepository
+	ExecutionRepo  repositories.ExecutionRepository // optional; nil under the "memory" backend
+	GroupRepo      repositories.GroupRepository     // optional; nil under the "memory" backend
+	CheckpointRepo repositories.CheckpointRepository
 
 	// Services
-	ToolManager   services.ToolManager
-	SystemService services.SystemService
-	LogService    services.LogService
+	ToolManager        services.ToolManager
+	SystemService      services.SystemService
+	LogService         services.LogService
+	ServiceUnitService services.ServiceUnitService
+	EventBus           services.InstanceEventBus
+	ManifestRegistry   services.ManifestRegistry
+	AdapterRegistry    services.AdapterRegistry
 
 	// Use Cases
-	LaunchTool    *usecases.LaunchToolUseCase
-	StopTool      *usecases.StopToolUseCase
-	CreateConfig  *usecases.CreateConfigurationUseCase
-	ListInstances *usecases.ListInstancesUseCase
-	GetSystemInfo *usecases.GetSystemInfoUseCase
+	LaunchTool          *usecases.LaunchToolUseCase
+	StopTool            *usecases.StopToolUseCase
+	CreateConfig        *usecases.CreateConfigurationUseCase
+	CloneConfig         *usecases.CloneConfigurationUseCase
+	CreateGroup         *usecases.CreateGroupUseCase
+	LaunchGroup         *usecases.LaunchGroupUseCase
+	StopGroup           *usecases.StopGroupUseCase
+	ListGroups          *usecases.ListGroupsUseCase
+	ListInstances       *usecases.ListInstancesUseCase
+	ListExecutions      *usecases.ListExecutionsUseCase
+	GetExecution        *usecases.GetExecutionUseCase
+	StopExecution       *usecases.StopExecutionUseCase
+	CheckpointInstance  *usecases.CheckpointInstanceUseCase
+	RestoreInstance     *usecases.RestoreInstanceUseCase
+	ListCheckpoints     *usecases.ListCheckpointsUseCase
+	PruneCheckpoints    *usecases.PruneCheckpointsUseCase
+	GetSystemInfo       *usecases.GetSystemInfoUseCase
+	GenerateServiceUnit *usecases.GenerateServiceUnitUseCase
 
 	// Wails App
 	WailsApp *wails.App
 }
 
-// NewContainer creates and wires up all dependencies
-func NewContainer() *Container {
+// NewContainer creates and wires up all dependencies. The persistence
+// backend is selected via the LAUNCHER_PERSISTENCE environment variable:
+// "memory" (the default) keeps configurations and instances in process
+// memory, losing all state on restart; "sql" persists them to the database
+// selected by sqlrepo.DBDriver/DBDSN (SQLite by default, PostgreSQL also
+// supported).
+func NewContainer() (*Container, error) {
 	c := &Container{}
 
 	// Initialize repositories
-	c.ConfigRepo = memory.NewConfigurationRepository()
-	c.InstanceRepo = memory.NewInstanceRepository()
+	if err := c.initRepositories(); err != nil {
+		return nil, err
+	}
 
 	// Initialize services
-	c.LogService = impl.NewLogService()
+	c.LogService = impl.NewLogService(logFormat(), logLevel(), logBufferCap(), logMaxSizeBytes(), logMaxBackups())
 	c.SystemService = impl.NewSystemService()
-	c.ToolManager = impl.NewToolManager(c.LogService)
+	c.EventBus = impl.NewInstanceEventBus()
+	c.AdapterRegistry = adapters.Default
+	c.ToolManager = impl.NewToolManager(c.LogService.Logger().Named("toolmanager"), c.EventBus, c.AdapterRegistry, c.LogService)
+	c.ServiceUnitService = impl.NewServiceUnitService()
+
+	manifestRegistry, err := newManifestRegistry()
+	if err != nil {
+		return nil, err
+	}
+	c.ManifestRegistry = manifestRegistry
+
+	checkpointRepo, err := newCheckpointRepository()
+	if err != nil {
+		return nil, err
+	}
+	c.CheckpointRepo = checkpointRepo
 
 	// Initialize use cases
 	c.LaunchTool = usecases.NewLaunchToolUseCase(
 		c.ConfigRepo,
 		c.InstanceRepo,
+		c.ExecutionRepo,
 		c.ToolManager,
-		c.LogService,
+		c.SystemService,
+		c.ManifestRegistry,
+		c.LogService.Logger().Named("launch_tool"),
 	)
 
 	c.StopTool = usecases.NewStopToolUseCase(
 		c.InstanceRepo,
 		c.ToolManager,
-		c.LogService,
+		c.LogService.Logger().Named("stop_tool"),
 	)
 
 	c.CreateConfig = usecases.NewCreateConfigurationUseCase(
 		c.ConfigRepo,
 		c.SystemService,
-		c.LogService,
+		c.LogService.Logger().Named("create_config"),
+	)
+
+	c.CloneConfig = usecases.NewCloneConfigurationUseCase(
+		c.ConfigRepo,
+		c.InstanceRepo,
+		c.ToolManager,
+		c.SystemService,
+		c.LaunchTool,
+		c.StopTool,
+		c.LogService.Logger().Named("clone_config"),
 	)
 
+	if c.GroupRepo != nil {
+		c.CreateGroup = usecases.NewCreateGroupUseCase(c.GroupRepo, c.LogService.Logger().Named("create_group"))
+		c.LaunchGroup = usecases.NewLaunchGroupUseCase(
+			c.GroupRepo,
+			c.ConfigRepo,
+			c.LaunchTool,
+			c.StopTool,
+			c.ToolManager,
+			c.LogService.Logger().Named("launch_group"),
+		)
+		c.StopGroup = usecases.NewStopGroupUseCase(c.GroupRepo, c.InstanceRepo, c.StopTool, c.LogService.Logger().Named("stop_group"))
+		c.ListGroups = usecases.NewListGroupsUseCase(c.GroupRepo)
+	}
+
 	c.ListInstances = usecases.NewListInstancesUseCase(c.InstanceRepo)
 
+	if c.ExecutionRepo != nil {
+		c.ListExecutions = usecases.NewListExecutionsUseCase(c.ExecutionRepo)
+		c.GetExecution = usecases.NewGetExecutionUseCase(c.ExecutionRepo)
+		c.StopExecution = usecases.NewStopExecutionUseCase(c.ExecutionRepo)
+	}
+
+	c.CheckpointInstance = usecases.NewCheckpointInstanceUseCase(
+		c.InstanceRepo,
+		c.CheckpointRepo,
+		c.ToolManager,
+		c.LogService.Logger().Named("checkpoint_instance"),
+	)
+	c.RestoreInstance = usecases.NewRestoreInstanceUseCase(
+		c.InstanceRepo,
+		c.CheckpointRepo,
+		c.ToolManager,
+		c.LogService.Logger().Named("restore_instance"),
+	)
+	c.ListCheckpoints = usecases.NewListCheckpointsUseCase(c.CheckpointRepo)
+	c.PruneCheckpoints = usecases.NewPruneCheckpointsUseCase(c.CheckpointRepo, c.LogService.Logger().Named("prune_checkpoints"))
+
 	c.GetSystemInfo = usecases.NewGetSystemInfoUseCase(c.SystemService)
 
+	c.GenerateServiceUnit = usecases.NewGenerateServiceUnitUseCase(c.ServiceUnitService)
+
 	// Initialize Wails app
 	c.WailsApp = wails.NewApp(
 		c.LaunchTool,
 		c.StopTool,
 		c.CreateConfig,
+		c.CloneConfig,
+		c.CreateGroup,
+		c.LaunchGroup,
+		c.StopGroup,
+		c.ListGroups,
 		c.ListInstances,
+		c.CheckpointInstance,
+		c.RestoreInstance,
+		c.ListCheckpoints,
+		c.PruneCheckpoints,
 		c.GetSystemInfo,
+		c.EventBus,
 	)
 
-	return c
+	// Relaunch AutoStart configurations from their latest checkpoint where
+	// one exists, matching what a Wails Startup hook would do on app
+	// launch; NewContainer is this codebase's nearest equivalent entry
+	// point until internal/interfaces/wails grows a real Startup hook.
+	c.restoreAutoStart(context.Background())
+
+	return c, nil
+}
+
+// restoreAutoStart relaunches every Configuration marked AutoStart that has
+// a checkpoint, via RestoreInstance, falling back to a cold LaunchTool when
+// it has none or the restore fails.
+func (c *Container) restoreAutoStart(ctx context.Context) {
+	log := c.LogService.Logger().Named("startup")
+
+	configs, err := c.ConfigRepo.FindAll(ctx)
+	if err != nil {
+		log.Error("failed to list configurations for auto-start", "error", err.Error())
+		return
+	}
+
+	checkpoints, err := c.CheckpointRepo.FindAll(ctx)
+	if err != nil {
+		log.Error("failed to list checkpoints for auto-start", "error", err.Error())
+		return
+	}
+	latestByConfig := make(map[string]entities.CheckpointData, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		if existing, ok := latestByConfig[checkpoint.Config.ID]; !ok || checkpoint.CreatedAt.After(existing.CreatedAt) {
+			latestByConfig[checkpoint.Config.ID] = checkpoint
+		}
+	}
+
+	for _, config := range configs {
+		if !config.AutoStart {
+			continue
+		}
+
+		if checkpoint, ok := latestByConfig[config.ID]; ok {
+			if _, err := c.RestoreInstance.Execute(ctx, checkpoint.InstanceID); err == nil {
+				continue
+			}
+			log.Warn("failed to restore from checkpoint, falling back to cold launch", "config_id", config.ID)
+		}
+
+		if _, err := c.LaunchTool.Execute(ctx, config.ID); err != nil {
+			log.Error("failed to auto-start configuration", "config_id", config.ID, "error", err.Error())
+		}
+	}
+}
+
+// initRepositories sets c.ConfigRepo/c.InstanceRepo to the backend chosen by
+// the LAUNCHER_PERSISTENCE environment variable.
+func (c *Container) initRepositories() error {
+	switch backend := persistenceBackend(); backend {
+	case "memory":
+		c.ConfigRepo = memory.NewConfigurationRepository()
+		c.InstanceRepo = memory.NewInstanceRepository()
+		return nil
+	case "sql":
+		db, err := sql.Open(sqlDriverName(sqlrepo.DBDriver()), sqlrepo.DBDSN())
+		if err != nil {
+			return fmt.Errorf("failed to open %s database: %w", sqlrepo.DBDriver(), err)
+		}
+
+		c.ConfigRepo, err = sqlrepo.NewConfigurationRepository(sqlrepo.DBDriver(), db)
+		if err != nil {
+			return err
+		}
+
+		c.InstanceRepo, err = sqlrepo.NewInstanceRepository(sqlrepo.DBDriver(), db)
+		if err != nil {
+			return err
+		}
+
+		c.ExecutionRepo, err = sqlrepo.NewExecutionRepository(sqlrepo.DBDriver(), db)
+		if err != nil {
+			return err
+		}
+
+		c.GroupRepo, err = sqlrepo.NewGroupRepository(sqlrepo.DBDriver(), db)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported LAUNCHER_PERSISTENCE backend %q", backend)
+	}
+}
+
+// persistenceBackend reads the configured repository backend from the
+// LAUNCHER_PERSISTENCE environment variable, defaulting to "memory" to
+// match the launcher's behavior before the "sql" backend existed.
+func persistenceBackend() string {
+	if backend := os.Getenv("LAUNCHER_PERSISTENCE"); backend != "" {
+		return backend
+	}
+	return "memory"
+}
+
+// sqlDriverName maps a sqlrepo dialect name to the database/sql driver
+// registered for it.
+func sqlDriverName(dialect string) string {
+	if dialect == "postgres" {
+		return "postgres"
+	}
+	return "sqlite3"
+}
+
+// logFormat reads the configured log output format from the
+// LAUNCHER_LOG_FORMAT environment variable ("json" or "text"), defaulting
+// to "text" for a desktop Wails install.
+func logFormat() services.LogFormat {
+	if os.Getenv("LAUNCHER_LOG_FORMAT") == "json" {
+		return services.LogFormatJSON
+	}
+	return services.LogFormatText
+}
+
+// logLevel reads the minimum emitted log level from the LAUNCHER_LOG_LEVEL
+// environment variable, defaulting to "info".
+func logLevel() services.LogLevel {
+	switch os.Getenv("LAUNCHER_LOG_LEVEL") {
+	case "trace":
+		return services.LevelTrace
+	case "debug":
+		return services.LevelDebug
+	case "warn":
+		return services.LevelWarn
+	case "error":
+		return services.LevelError
+	default:
+		return services.LevelInfo
+	}
+}
+
+// logBufferCap reads the per-instance in-memory log entry cap from the
+// LAUNCHER_LOG_BUFFER_CAP environment variable, defaulting to 0 (which
+// tells impl.NewLogService to use its own built-in default) if unset or
+// not a positive integer.
+func logBufferCap() int {
+	parsed, err := strconv.Atoi(os.Getenv("LAUNCHER_LOG_BUFFER_CAP"))
+	if err != nil || parsed <= 0 {
+		return 0
+	}
+	return parsed
+}
+
+// logMaxSizeBytes reads the per-instance log file rotation threshold from
+// the LAUNCHER_LOG_MAX_SIZE_BYTES environment variable, defaulting to 0
+// (which tells impl.NewLogService to use its own built-in default) if unset
+// or not a positive integer.
+func logMaxSizeBytes() int64 {
+	parsed, err := strconv.ParseInt(os.Getenv("LAUNCHER_LOG_MAX_SIZE_BYTES"), 10, 64)
+	if err != nil || parsed <= 0 {
+		return 0
+	}
+	return parsed
+}
+
+// logMaxBackups reads how many gzip-compressed rotated log backups to keep
+// per instance from the LAUNCHER_LOG_MAX_BACKUPS environment variable,
+// defaulting to 0 (which tells impl.NewLogService to use its own built-in
+// default) if unset or not a positive integer.
+func logMaxBackups() int {
+	parsed, err := strconv.Atoi(os.Getenv("LAUNCHER_LOG_MAX_BACKUPS"))
+	if err != nil || parsed <= 0 {
+		return 0
+	}
+	return parsed
+}
+
+// newManifestRegistry creates the manifest registry used to pin/share
+// Configurations by content digest. Env vars: LAUNCHER_MANIFEST_DIR (blob
+// store location, default ~/.ai-launcher/manifests); LAUNCHER_MANIFEST_PUBLIC_KEY
+// (hex-encoded ed25519 public key trusted to verify signed manifests;
+// unset makes Verify reject every signed manifest); LAUNCHER_MANIFEST_REQUIRE_SIGNATURE
+// ("true" to refuse unsigned manifests outright).
+func newManifestRegistry() (services.ManifestRegistry, error) {
+	dir := os.Getenv("LAUNCHER_MANIFEST_DIR")
+	if dir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			homeDir = "."
+		}
+		dir = filepath.Join(homeDir, ".ai-launcher", "manifests")
+	}
+
+	var publicKey ed25519.PublicKey
+	if encoded := os.Getenv("LAUNCHER_MANIFEST_PUBLIC_KEY"); encoded != "" {
+		decoded, err := hex.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid LAUNCHER_MANIFEST_PUBLIC_KEY: %w", err)
+		}
+		publicKey = ed25519.PublicKey(decoded)
+	}
+
+	requireSig := os.Getenv("LAUNCHER_MANIFEST_REQUIRE_SIGNATURE") == "true"
+
+	return manifest.NewRegistry(dir, publicKey, requireSig)
+}
+
+// newCheckpointRepository creates the repository used to persist
+// CheckpointData snapshots. Env var: LAUNCHER_CHECKPOINT_DIR (JSON store
+// location, default ~/.ai-launcher/checkpoints).
+func newCheckpointRepository() (repositories.CheckpointRepository, error) {
+	dir := os.Getenv("LAUNCHER_CHECKPOINT_DIR")
+	if dir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			homeDir = "."
+		}
+		dir = filepath.Join(homeDir, ".ai-launcher", "checkpoints")
+	}
+
+	return memory.NewCheckpointRepository(dir)
 }
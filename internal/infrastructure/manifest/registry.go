@@ -0,0 +1,134 @@
+// Package manifest is a local, file-backed implementation of
+// services.ManifestRegistry: manifests are stored as one JSON blob per
+// digest, and aliases as one small file per alias name pointing at a
+// digest, both under a configured base directory.
+package manifest
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/manifest"
+)
+
+// Registry is a services.ManifestRegistry backed by a local blob store.
+type Registry struct {
+	mu         sync.Mutex
+	baseDir    string
+	publicKey  ed25519.PublicKey // nil: Verify fails signed manifests
+	requireSig bool
+}
+
+// NewRegistry creates a registry rooted at baseDir, creating it if
+// necessary. If publicKey is nil, signed manifests can't be verified
+// (Verify fails them); if requireSig is true, Verify also rejects manifests
+// with no signature at all.
+func NewRegistry(baseDir string, publicKey ed25519.PublicKey, requireSig bool) (*Registry, error) {
+	if err := os.MkdirAll(filepath.Join(baseDir, "blobs"), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create manifest blob store: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(baseDir, "aliases"), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create manifest alias store: %w", err)
+	}
+	return &Registry{baseDir: baseDir, publicKey: publicKey, requireSig: requireSig}, nil
+}
+
+// Push computes m.Config's digest, verifies any signature per the
+// registry's policy, and stores m under that digest.
+func (r *Registry) Push(m entities.ConfigurationManifest) (string, error) {
+	digest, err := manifest.Digest(m.Config)
+	if err != nil {
+		return "", err
+	}
+	m.Digest = digest
+
+	if err := r.Verify(m); err != nil {
+		return "", err
+	}
+
+	encoded, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.WriteFile(r.blobPath(digest), encoded, 0644); err != nil {
+		return "", fmt.Errorf("failed to store manifest %s: %w", digest, err)
+	}
+	return digest, nil
+}
+
+// Pull retrieves the manifest stored under digest.
+func (r *Registry) Pull(digest string) (entities.ConfigurationManifest, error) {
+	r.mu.Lock()
+	data, err := os.ReadFile(r.blobPath(digest))
+	r.mu.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entities.ConfigurationManifest{}, fmt.Errorf("manifest %s not found", digest)
+		}
+		return entities.ConfigurationManifest{}, err
+	}
+
+	var m entities.ConfigurationManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return entities.ConfigurationManifest{}, fmt.Errorf("failed to decode manifest %s: %w", digest, err)
+	}
+	return m, nil
+}
+
+// Resolve looks up the digest alias currently points to.
+func (r *Registry) Resolve(alias string) (string, error) {
+	r.mu.Lock()
+	data, err := os.ReadFile(r.aliasPath(alias))
+	r.mu.Unlock()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("alias %q not found", alias)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetAlias points alias at digest, overwriting any previous target. It is
+// not part of services.ManifestRegistry: aliases are assigned out of band
+// (e.g. by a CLI --alias flag) rather than as part of a launch.
+func (r *Registry) SetAlias(alias, digest string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return os.WriteFile(r.aliasPath(alias), []byte(digest), 0644)
+}
+
+// Verify checks m's signature per the registry's signing policy: an
+// unsigned manifest is accepted unless requireSig is set; a signed one is
+// checked against the configured public key.
+func (r *Registry) Verify(m entities.ConfigurationManifest) error {
+	if len(m.Signature) == 0 {
+		if r.requireSig {
+			return fmt.Errorf("manifest %s requires a signature but has none", m.Digest)
+		}
+		return nil
+	}
+	if r.publicKey == nil {
+		return fmt.Errorf("manifest %s is signed but no trusted public key is configured", m.Digest)
+	}
+	return manifest.VerifySignature(r.publicKey, m)
+}
+
+// blobPath returns digest's on-disk path, with ':' escaped so the digest's
+// "sha256:" prefix doesn't collide with path separators on any platform.
+func (r *Registry) blobPath(digest string) string {
+	return filepath.Join(r.baseDir, "blobs", strings.ReplaceAll(digest, ":", "_")+".json")
+}
+
+func (r *Registry) aliasPath(alias string) string {
+	return filepath.Join(r.baseDir, "aliases", alias)
+}
@@ -0,0 +1,158 @@
+// Package memory holds in-process repository implementations.
+// CheckpointRepository is its only implementation: checkpoints are
+// meant to outlive the process that captured them, so each one is also
+// persisted as a JSON file on disk rather than kept purely in memory.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// CheckpointRepository is a repositories.CheckpointRepository that keeps
+// checkpoints in memory for fast lookup, mirroring InstanceRepository's
+// in-memory shape, while persisting each one as a JSON file under dir so
+// they survive a restart.
+type CheckpointRepository struct {
+	mu          sync.RWMutex
+	dir         string
+	checkpoints map[string]entities.CheckpointData
+}
+
+// NewCheckpointRepository creates a repository rooted at dir, creating it
+// if necessary, and loads any checkpoints already persisted there from a
+// previous run.
+func NewCheckpointRepository(dir string) (*CheckpointRepository, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint store: %w", err)
+	}
+
+	r := &CheckpointRepository{dir: dir, checkpoints: make(map[string]entities.CheckpointData)}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// load reads every previously persisted checkpoint in r.dir into memory.
+func (r *CheckpointRepository) load() error {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return fmt.Errorf("failed to list checkpoint store: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(r.dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read checkpoint %s: %w", entry.Name(), err)
+		}
+
+		var checkpoint entities.CheckpointData
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return fmt.Errorf("failed to decode checkpoint %s: %w", entry.Name(), err)
+		}
+		r.checkpoints[checkpoint.ID] = checkpoint
+	}
+	return nil
+}
+
+func (r *CheckpointRepository) path(id string) string {
+	return filepath.Join(r.dir, id+".json")
+}
+
+// Save stores checkpoint, in memory and on disk.
+func (r *CheckpointRepository) Save(ctx context.Context, checkpoint entities.CheckpointData) error {
+	encoded, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.WriteFile(r.path(checkpoint.ID), encoded, 0644); err != nil {
+		return fmt.Errorf("failed to persist checkpoint %s: %w", checkpoint.ID, err)
+	}
+	r.checkpoints[checkpoint.ID] = checkpoint
+	return nil
+}
+
+// FindByID retrieves a checkpoint by ID.
+func (r *CheckpointRepository) FindByID(ctx context.Context, id string) (*entities.CheckpointData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checkpoint, ok := r.checkpoints[id]
+	if !ok {
+		return nil, fmt.Errorf("checkpoint with ID %s not found", id)
+	}
+	return &checkpoint, nil
+}
+
+// FindByInstance retrieves every checkpoint captured for instanceID,
+// oldest first.
+func (r *CheckpointRepository) FindByInstance(ctx context.Context, instanceID string) ([]entities.CheckpointData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found []entities.CheckpointData
+	for _, checkpoint := range r.checkpoints {
+		if checkpoint.InstanceID == instanceID {
+			found = append(found, checkpoint)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].CreatedAt.Before(found[j].CreatedAt) })
+	return found, nil
+}
+
+// FindLatestByInstance retrieves the most recently captured checkpoint for
+// instanceID.
+func (r *CheckpointRepository) FindLatestByInstance(ctx context.Context, instanceID string) (*entities.CheckpointData, error) {
+	checkpoints, err := r.FindByInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(checkpoints) == 0 {
+		return nil, fmt.Errorf("no checkpoints found for instance %s", instanceID)
+	}
+	latest := checkpoints[len(checkpoints)-1]
+	return &latest, nil
+}
+
+// FindAll retrieves every checkpoint across all instances.
+func (r *CheckpointRepository) FindAll(ctx context.Context) ([]entities.CheckpointData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	all := make([]entities.CheckpointData, 0, len(r.checkpoints))
+	for _, checkpoint := range r.checkpoints {
+		all = append(all, checkpoint)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt.Before(all[j].CreatedAt) })
+	return all, nil
+}
+
+// Delete removes a checkpoint, in memory and on disk.
+func (r *CheckpointRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.checkpoints[id]; !ok {
+		return fmt.Errorf("checkpoint with ID %s not found", id)
+	}
+	if err := os.Remove(r.path(id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove checkpoint %s: %w", id, err)
+	}
+	delete(r.checkpoints, id)
+	return nil
+}
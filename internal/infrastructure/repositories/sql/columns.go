@@ -0,0 +1,255 @@
+// Package sql implements repositories.ConfigurationRepository and
+// repositories.InstanceRepository against a SQL database (SQLite by
+// default for a desktop Wails install, PostgreSQL also supported), so an
+// instance's configuration and launch history survive a process restart.
+// Fixed-shape queries are duplicated per dialect the same way
+// infrastructure/repository/{sqlite,postgresql}_project_repository.go
+// split them, since the dialects diverge on placeholder style; this file
+// holds the column list and scan/serialize helpers both dialects share.
+package sql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+const configInsertColumns = `id, name, type, executable_path, working_dir, port, host,
+	arguments_json, environment_json, auto_start, health_check_json, restart_policy_json`
+
+const configColumns = configInsertColumns + `, created_at, updated_at`
+
+const instanceColumns = `id, config_id, config_type, config_json, status, pid,
+	started_at, stopped_at, exit_code, last_error, log_file_path, restart_attempts, next_restart_at`
+
+const groupInsertColumns = `id, name, config_ids_json, depends_on_json, shared_env_json, restart_policy_json`
+
+const groupColumns = groupInsertColumns + `, created_at, updated_at`
+
+const executionColumns = `id, config_id, status, total, failed, succeeded, in_progress, trigger, start_time, end_time`
+
+const taskColumns = `id, execution_id, sequence, kind, status, start_time, end_time, error`
+
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanConfiguration(s scanner, cfg *entities.Configuration) error {
+	var argumentsJSON, environmentJSON, restartPolicyJSON string
+	var healthCheckJSON sql.NullString
+
+	if err := s.Scan(&cfg.ID, &cfg.Name, &cfg.Type, &cfg.ExecutablePath, &cfg.WorkingDir, &cfg.Port, &cfg.Host,
+		&argumentsJSON, &environmentJSON, &cfg.AutoStart, &healthCheckJSON, &restartPolicyJSON,
+		&cfg.CreatedAt, &cfg.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal([]byte(argumentsJSON), &cfg.Arguments); err != nil {
+		return fmt.Errorf("decode arguments: %w", err)
+	}
+	if err := json.Unmarshal([]byte(environmentJSON), &cfg.Environment); err != nil {
+		return fmt.Errorf("decode environment: %w", err)
+	}
+	if err := json.Unmarshal([]byte(restartPolicyJSON), &cfg.RestartPolicy); err != nil {
+		return fmt.Errorf("decode restart policy: %w", err)
+	}
+	if healthCheckJSON.Valid {
+		cfg.HealthCheck = &entities.HealthCheck{}
+		if err := json.Unmarshal([]byte(healthCheckJSON.String), cfg.HealthCheck); err != nil {
+			return fmt.Errorf("decode health check: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// configurationArgs builds the positional argument list matching
+// configColumns, less created_at/updated_at which the dialect-specific
+// INSERT/UPDATE statements supply via CURRENT_TIMESTAMP/RETURNING.
+func configurationArgs(cfg entities.Configuration) (args []interface{}, err error) {
+	argumentsJSON, err := json.Marshal(cfg.Arguments)
+	if err != nil {
+		return nil, fmt.Errorf("encode arguments: %w", err)
+	}
+	environmentJSON, err := json.Marshal(cfg.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("encode environment: %w", err)
+	}
+	restartPolicyJSON, err := json.Marshal(cfg.RestartPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("encode restart policy: %w", err)
+	}
+	var healthCheckJSON sql.NullString
+	if cfg.HealthCheck != nil {
+		b, err := json.Marshal(cfg.HealthCheck)
+		if err != nil {
+			return nil, fmt.Errorf("encode health check: %w", err)
+		}
+		healthCheckJSON = sql.NullString{String: string(b), Valid: true}
+	}
+
+	return []interface{}{
+		cfg.ID, cfg.Name, cfg.Type, cfg.ExecutablePath, cfg.WorkingDir, cfg.Port, cfg.Host,
+		string(argumentsJSON), string(environmentJSON), cfg.AutoStart, healthCheckJSON, string(restartPolicyJSON),
+	}, nil
+}
+
+func scanInstance(s scanner) (entities.AIToolInstance, error) {
+	var inst entities.AIToolInstance
+	var configID, configType, configJSON string
+	var exitCode sql.NullInt64
+
+	if err := s.Scan(&inst.ID, &configID, &configType, &configJSON, &inst.Status, &inst.PID,
+		&inst.StartedAt, &inst.StoppedAt, &exitCode, &inst.LastError, &inst.LogFilePath,
+		&inst.RestartAttempts, &inst.NextRestartAt); err != nil {
+		return inst, err
+	}
+
+	if err := json.Unmarshal([]byte(configJSON), &inst.Config); err != nil {
+		return inst, fmt.Errorf("decode config snapshot: %w", err)
+	}
+	if exitCode.Valid {
+		code := int(exitCode.Int64)
+		inst.ExitCode = &code
+	}
+
+	return inst, nil
+}
+
+// instanceArgs builds the positional argument list matching instanceColumns.
+// Config is stored as a full JSON snapshot (rather than joined from
+// configurations at read time) so an instance's launch history keeps
+// reflecting the configuration as it was when the instance was launched,
+// even if the configuration is edited or deleted afterward.
+func instanceArgs(inst entities.AIToolInstance) (args []interface{}, err error) {
+	configJSON, err := json.Marshal(inst.Config)
+	if err != nil {
+		return nil, fmt.Errorf("encode config snapshot: %w", err)
+	}
+
+	var exitCode sql.NullInt64
+	if inst.ExitCode != nil {
+		exitCode = sql.NullInt64{Int64: int64(*inst.ExitCode), Valid: true}
+	}
+
+	return []interface{}{
+		inst.ID, inst.Config.ID, string(inst.Config.Type), string(configJSON), inst.Status, inst.PID,
+		inst.StartedAt, inst.StoppedAt, exitCode, inst.LastError, inst.LogFilePath,
+		inst.RestartAttempts, inst.NextRestartAt,
+	}, nil
+}
+
+func scanGroup(s scanner, group *entities.InstanceGroup) error {
+	var configIDsJSON, dependsOnJSON, sharedEnvJSON, restartPolicyJSON string
+
+	if err := s.Scan(&group.ID, &group.Name, &configIDsJSON, &dependsOnJSON, &sharedEnvJSON,
+		&restartPolicyJSON, &group.CreatedAt, &group.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal([]byte(configIDsJSON), &group.ConfigIDs); err != nil {
+		return fmt.Errorf("decode config ids: %w", err)
+	}
+	if err := json.Unmarshal([]byte(dependsOnJSON), &group.DependsOn); err != nil {
+		return fmt.Errorf("decode depends on: %w", err)
+	}
+	if err := json.Unmarshal([]byte(sharedEnvJSON), &group.SharedEnv); err != nil {
+		return fmt.Errorf("decode shared env: %w", err)
+	}
+	if err := json.Unmarshal([]byte(restartPolicyJSON), &group.RestartPolicy); err != nil {
+		return fmt.Errorf("decode restart policy: %w", err)
+	}
+
+	return nil
+}
+
+// groupArgs builds the positional argument list matching groupInsertColumns,
+// less created_at/updated_at which the dialect-specific INSERT/UPDATE
+// statements supply via CURRENT_TIMESTAMP.
+func groupArgs(group entities.InstanceGroup) (args []interface{}, err error) {
+	configIDsJSON, err := json.Marshal(group.ConfigIDs)
+	if err != nil {
+		return nil, fmt.Errorf("encode config ids: %w", err)
+	}
+	dependsOnJSON, err := json.Marshal(group.DependsOn)
+	if err != nil {
+		return nil, fmt.Errorf("encode depends on: %w", err)
+	}
+	sharedEnvJSON, err := json.Marshal(group.SharedEnv)
+	if err != nil {
+		return nil, fmt.Errorf("encode shared env: %w", err)
+	}
+	restartPolicyJSON, err := json.Marshal(group.RestartPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("encode restart policy: %w", err)
+	}
+
+	return []interface{}{
+		group.ID, group.Name, string(configIDsJSON), string(dependsOnJSON),
+		string(sharedEnvJSON), string(restartPolicyJSON),
+	}, nil
+}
+
+func scanExecution(s scanner) (entities.LaunchExecution, error) {
+	var exec entities.LaunchExecution
+	var trigger sql.NullString
+
+	if err := s.Scan(&exec.ID, &exec.ConfigID, &exec.Status, &exec.Total, &exec.Failed, &exec.Succeeded,
+		&exec.InProgress, &trigger, &exec.StartTime, &exec.EndTime); err != nil {
+		return exec, err
+	}
+
+	exec.Trigger = trigger.String
+	return exec, nil
+}
+
+// executionArgs builds the positional argument list matching
+// executionColumns.
+func executionArgs(exec entities.LaunchExecution) []interface{} {
+	return []interface{}{
+		exec.ID, exec.ConfigID, exec.Status, exec.Total, exec.Failed, exec.Succeeded,
+		exec.InProgress, exec.Trigger, exec.StartTime, exec.EndTime,
+	}
+}
+
+func scanTask(s scanner) (entities.LaunchTask, error) {
+	var task entities.LaunchTask
+	var taskErr sql.NullString
+
+	if err := s.Scan(&task.ID, &task.ExecutionID, &task.Sequence, &task.Kind, &task.Status,
+		&task.StartTime, &task.EndTime, &taskErr); err != nil {
+		return task, err
+	}
+
+	task.Error = taskErr.String
+	return task, nil
+}
+
+// taskArgs builds the positional argument list matching taskColumns.
+func taskArgs(task entities.LaunchTask) []interface{} {
+	var taskErr sql.NullString
+	if task.Error != "" {
+		taskErr = sql.NullString{String: task.Error, Valid: true}
+	}
+
+	return []interface{}{
+		task.ID, task.ExecutionID, task.Sequence, task.Kind, task.Status, task.StartTime, task.EndTime, taskErr,
+	}
+}
+
+// requireRowsAffected returns notFound if result reports zero rows affected,
+// matching the in-memory repositories' behavior of rejecting Delete/Update
+// calls for an id that was never Saved.
+func requireRowsAffected(result sql.Result, notFound error) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/ai-launcher/internal/domain/repositories"
+)
+
+// NewConfigurationRepository opens db and returns the
+// repositories.ConfigurationRepository implementation matching driver
+// ("sqlite" or "postgres"), with its migrations already applied. driver is
+// typically read from DBDriver.
+func NewConfigurationRepository(driver string, db *sql.DB) (repositories.ConfigurationRepository, error) {
+	var repo interface {
+		repositories.ConfigurationRepository
+		Initialize() error
+	}
+
+	switch driver {
+	case "", "sqlite":
+		repo = NewSQLiteConfigurationRepository(db)
+	case "postgres":
+		repo = NewPostgreSQLConfigurationRepository(db)
+	default:
+		return nil, fmt.Errorf("unsupported configuration repository driver %q", driver)
+	}
+
+	if err := repo.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s configuration repository: %w", driver, err)
+	}
+
+	return repo, nil
+}
+
+// NewInstanceRepository opens db and returns the
+// repositories.InstanceRepository implementation matching driver ("sqlite"
+// or "postgres"), with its migrations already applied. driver is typically
+// read from DBDriver.
+func NewInstanceRepository(driver string, db *sql.DB) (repositories.InstanceRepository, error) {
+	var repo interface {
+		repositories.InstanceRepository
+		Initialize() error
+	}
+
+	switch driver {
+	case "", "sqlite":
+		repo = NewSQLiteInstanceRepository(db)
+	case "postgres":
+		repo = NewPostgreSQLInstanceRepository(db)
+	default:
+		return nil, fmt.Errorf("unsupported instance repository driver %q", driver)
+	}
+
+	if err := repo.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s instance repository: %w", driver, err)
+	}
+
+	return repo, nil
+}
+
+// NewExecutionRepository opens db and returns the
+// repositories.ExecutionRepository implementation matching driver
+// ("sqlite" or "postgres"), with its migrations already applied. driver is
+// typically read from DBDriver.
+func NewExecutionRepository(driver string, db *sql.DB) (repositories.ExecutionRepository, error) {
+	var repo interface {
+		repositories.ExecutionRepository
+		Initialize() error
+	}
+
+	switch driver {
+	case "", "sqlite":
+		repo = NewSQLiteExecutionRepository(db)
+	case "postgres":
+		repo = NewPostgreSQLExecutionRepository(db)
+	default:
+		return nil, fmt.Errorf("unsupported execution repository driver %q", driver)
+	}
+
+	if err := repo.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s execution repository: %w", driver, err)
+	}
+
+	return repo, nil
+}
+
+// NewGroupRepository opens db and returns the repositories.GroupRepository
+// implementation matching driver ("sqlite" or "postgres"), with its
+// migrations already applied. driver is typically read from DBDriver.
+func NewGroupRepository(driver string, db *sql.DB) (repositories.GroupRepository, error) {
+	var repo interface {
+		repositories.GroupRepository
+		Initialize() error
+	}
+
+	switch driver {
+	case "", "sqlite":
+		repo = NewSQLiteGroupRepository(db)
+	case "postgres":
+		repo = NewPostgreSQLGroupRepository(db)
+	default:
+		return nil, fmt.Errorf("unsupported group repository driver %q", driver)
+	}
+
+	if err := repo.Initialize(); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s group repository: %w", driver, err)
+	}
+
+	return repo, nil
+}
+
+// DBDriver reads the configured SQL repository driver from the
+// LAUNCHER_DB_DRIVER environment variable, defaulting to "sqlite" to match
+// a desktop Wails install that has no database server to point at.
+func DBDriver() string {
+	if driver := os.Getenv("LAUNCHER_DB_DRIVER"); driver != "" {
+		return driver
+	}
+	return "sqlite"
+}
+
+// DBDSN reads the configured SQL data source name from the LAUNCHER_DB_DSN
+// environment variable, defaulting to a SQLite file in the working
+// directory.
+func DBDSN() string {
+	if dsn := os.Getenv("LAUNCHER_DB_DSN"); dsn != "" {
+		return dsn
+	}
+	return "ai-launcher.db"
+}
@@ -0,0 +1,184 @@
+// Package migrations runs versioned, numbered .sql files against a
+// SQL-backed repository's database, tracking applied versions in a
+// schema_migrations table. Each file holds an "-- +migrate Up" section and
+// an "-- +migrate Down" section; only Up is run automatically, Down is
+// available for manual rollback.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed postgres/*.sql
+var postgresFS embed.FS
+
+//go:embed sqlite/*.sql
+var sqliteFS embed.FS
+
+// migration is a single parsed, numbered .sql file.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+// Run applies all pending migrations for the given dialect ("postgres" or
+// "sqlite") to db, in version order, recording each applied version in
+// schema_migrations. It is safe to call on every startup.
+func Run(db *sql.DB, dialect string) error {
+	var source fs.FS
+	switch dialect {
+	case "postgres":
+		source = postgresFS
+	case "sqlite":
+		source = sqliteFS
+	default:
+		return fmt.Errorf("migrations: unsupported dialect %q", dialect)
+	}
+
+	migrations, err := load(source, dialect)
+	if err != nil {
+		return fmt.Errorf("migrations: failed to load: %w", err)
+	}
+
+	if err := ensureSchemaMigrationsTable(db); err != nil {
+		return fmt.Errorf("migrations: failed to create schema_migrations table: %w", err)
+	}
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return fmt.Errorf("migrations: failed to read applied versions: %w", err)
+	}
+
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+
+		if err := apply(db, dialect, m); err != nil {
+			return fmt.Errorf("migrations: failed to apply %04d_%s: %w", m.version, m.name, err)
+		}
+	}
+
+	return nil
+}
+
+func load(source fs.FS, dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(source, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []migration
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		version, name, err := parseFilename(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		contents, err := fs.ReadFile(source, dir+"/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		up, down := splitUpDown(string(contents))
+		migrations = append(migrations, migration{version: version, name: name, up: up, down: down})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
+
+func parseFilename(filename string) (version int, name string, err error) {
+	base := strings.TrimSuffix(filename, ".sql")
+	parts := strings.SplitN(base, "_", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("migration filename %q must be of the form NNNN_name.sql", filename)
+	}
+
+	version, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("migration filename %q must start with a numeric version: %w", filename, err)
+	}
+
+	return version, parts[1], nil
+}
+
+func splitUpDown(contents string) (up, down string) {
+	const upMarker = "-- +migrate Up"
+	const downMarker = "-- +migrate Down"
+
+	upIdx := strings.Index(contents, upMarker)
+	downIdx := strings.Index(contents, downMarker)
+
+	if upIdx == -1 || downIdx == -1 {
+		return contents, ""
+	}
+
+	up = contents[upIdx+len(upMarker) : downIdx]
+	down = contents[downIdx+len(downMarker):]
+	return up, down
+}
+
+func apply(db *sql.DB, dialect string, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.up); err != nil {
+		return err
+	}
+
+	recordQuery := "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)"
+	if dialect == "sqlite" {
+		recordQuery = "INSERT INTO schema_migrations (version, name) VALUES (?, ?)"
+	}
+	if _, err := tx.Exec(recordQuery, m.version, m.name); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func ensureSchemaMigrationsTable(db *sql.DB) error {
+	ddl := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`
+	_, err := db.Exec(ddl)
+	return err
+}
+
+func appliedVersions(db *sql.DB) (map[int]bool, error) {
+	rows, err := db.Query("SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]bool{}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
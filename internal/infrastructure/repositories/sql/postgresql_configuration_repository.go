@@ -0,0 +1,122 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// PostgreSQLConfigurationRepository is a repositories.ConfigurationRepository
+// backed by PostgreSQL.
+type PostgreSQLConfigurationRepository struct {
+	db *sql.DB
+}
+
+func NewPostgreSQLConfigurationRepository(db *sql.DB) *PostgreSQLConfigurationRepository {
+	return &PostgreSQLConfigurationRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *PostgreSQLConfigurationRepository) Initialize() error {
+	return migrations.Run(r.db, "postgres")
+}
+
+func (r *PostgreSQLConfigurationRepository) Save(ctx context.Context, config entities.Configuration) error {
+	args, err := configurationArgs(config)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO configurations (` + configInsertColumns + `, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (id) DO UPDATE SET
+			name = excluded.name, type = excluded.type, executable_path = excluded.executable_path,
+			working_dir = excluded.working_dir, port = excluded.port, host = excluded.host,
+			arguments_json = excluded.arguments_json, environment_json = excluded.environment_json,
+			auto_start = excluded.auto_start, health_check_json = excluded.health_check_json,
+			restart_policy_json = excluded.restart_policy_json, updated_at = CURRENT_TIMESTAMP`
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *PostgreSQLConfigurationRepository) FindByID(ctx context.Context, id string) (*entities.Configuration, error) {
+	cfg := &entities.Configuration{}
+	query := `SELECT ` + configColumns + ` FROM configurations WHERE id = $1`
+
+	if err := scanConfiguration(r.db.QueryRowContext(ctx, query, id), cfg); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("configuration with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (r *PostgreSQLConfigurationRepository) FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.Configuration, error) {
+	query := `SELECT ` + configColumns + ` FROM configurations WHERE type = $1 ORDER BY created_at DESC`
+	return r.queryConfigurations(ctx, query, toolType)
+}
+
+func (r *PostgreSQLConfigurationRepository) FindAll(ctx context.Context) ([]entities.Configuration, error) {
+	query := `SELECT ` + configColumns + ` FROM configurations ORDER BY created_at DESC`
+	return r.queryConfigurations(ctx, query)
+}
+
+func (r *PostgreSQLConfigurationRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM configurations WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("configuration with ID %s not found", id))
+}
+
+func (r *PostgreSQLConfigurationRepository) Update(ctx context.Context, config entities.Configuration) error {
+	args, err := configurationArgs(config)
+	if err != nil {
+		return err
+	}
+	// configurationArgs returns (id, name, type, executable_path, working_dir,
+	// port, host, arguments_json, environment_json, auto_start,
+	// health_check_json, restart_policy_json); move id to the end for the
+	// WHERE clause.
+	args = append(args[1:], args[0])
+
+	query := `
+		UPDATE configurations
+		SET name = $1, type = $2, executable_path = $3, working_dir = $4, port = $5, host = $6,
+			arguments_json = $7, environment_json = $8, auto_start = $9, health_check_json = $10,
+			restart_policy_json = $11, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $12`
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("configuration with ID %s not found", config.ID))
+}
+
+func (r *PostgreSQLConfigurationRepository) queryConfigurations(ctx context.Context, query string, args ...interface{}) ([]entities.Configuration, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var configs []entities.Configuration
+	for rows.Next() {
+		var cfg entities.Configuration
+		if err := scanConfiguration(rows, &cfg); err != nil {
+			return nil, err
+		}
+		configs = append(configs, cfg)
+	}
+
+	return configs, rows.Err()
+}
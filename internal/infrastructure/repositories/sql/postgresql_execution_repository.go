@@ -0,0 +1,108 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// PostgreSQLExecutionRepository is a repositories.ExecutionRepository
+// backed by PostgreSQL.
+type PostgreSQLExecutionRepository struct {
+	db *sql.DB
+}
+
+func NewPostgreSQLExecutionRepository(db *sql.DB) *PostgreSQLExecutionRepository {
+	return &PostgreSQLExecutionRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *PostgreSQLExecutionRepository) Initialize() error {
+	return migrations.Run(r.db, "postgres")
+}
+
+func (r *PostgreSQLExecutionRepository) SaveExecution(ctx context.Context, execution entities.LaunchExecution) error {
+	query := `
+		INSERT INTO launch_executions (` + executionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			config_id = excluded.config_id, status = excluded.status, total = excluded.total,
+			failed = excluded.failed, succeeded = excluded.succeeded, in_progress = excluded.in_progress,
+			trigger = excluded.trigger, start_time = excluded.start_time, end_time = excluded.end_time`
+
+	_, err := r.db.ExecContext(ctx, query, executionArgs(execution)...)
+	return err
+}
+
+func (r *PostgreSQLExecutionRepository) FindExecutionByID(ctx context.Context, id string) (*entities.LaunchExecution, error) {
+	query := `SELECT ` + executionColumns + ` FROM launch_executions WHERE id = $1`
+
+	exec, err := scanExecution(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("launch execution with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &exec, nil
+}
+
+func (r *PostgreSQLExecutionRepository) FindExecutions(ctx context.Context) ([]entities.LaunchExecution, error) {
+	query := `SELECT ` + executionColumns + ` FROM launch_executions ORDER BY start_time DESC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []entities.LaunchExecution
+	for rows.Next() {
+		exec, err := scanExecution(rows)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, exec)
+	}
+
+	return executions, rows.Err()
+}
+
+func (r *PostgreSQLExecutionRepository) SaveTask(ctx context.Context, task entities.LaunchTask) error {
+	query := `
+		INSERT INTO launch_tasks (` + taskColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			execution_id = excluded.execution_id, sequence = excluded.sequence, kind = excluded.kind,
+			status = excluded.status, start_time = excluded.start_time, end_time = excluded.end_time,
+			error = excluded.error`
+
+	_, err := r.db.ExecContext(ctx, query, taskArgs(task)...)
+	return err
+}
+
+func (r *PostgreSQLExecutionRepository) FindTasksByExecution(ctx context.Context, executionID string) ([]entities.LaunchTask, error) {
+	query := `SELECT ` + taskColumns + ` FROM launch_tasks WHERE execution_id = $1 ORDER BY sequence ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, executionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entities.LaunchTask
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
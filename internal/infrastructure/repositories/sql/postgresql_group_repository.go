@@ -0,0 +1,110 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// PostgreSQLGroupRepository is a repositories.GroupRepository backed by
+// PostgreSQL.
+type PostgreSQLGroupRepository struct {
+	db *sql.DB
+}
+
+func NewPostgreSQLGroupRepository(db *sql.DB) *PostgreSQLGroupRepository {
+	return &PostgreSQLGroupRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *PostgreSQLGroupRepository) Initialize() error {
+	return migrations.Run(r.db, "postgres")
+}
+
+func (r *PostgreSQLGroupRepository) Save(ctx context.Context, group entities.InstanceGroup) error {
+	args, err := groupArgs(group)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO instance_groups (` + groupInsertColumns + `, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (id) DO UPDATE SET
+			name = excluded.name, config_ids_json = excluded.config_ids_json,
+			depends_on_json = excluded.depends_on_json, shared_env_json = excluded.shared_env_json,
+			restart_policy_json = excluded.restart_policy_json, updated_at = CURRENT_TIMESTAMP`
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *PostgreSQLGroupRepository) FindByID(ctx context.Context, id string) (*entities.InstanceGroup, error) {
+	group := &entities.InstanceGroup{}
+	query := `SELECT ` + groupColumns + ` FROM instance_groups WHERE id = $1`
+
+	if err := scanGroup(r.db.QueryRowContext(ctx, query, id), group); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("group with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (r *PostgreSQLGroupRepository) FindAll(ctx context.Context) ([]entities.InstanceGroup, error) {
+	query := `SELECT ` + groupColumns + ` FROM instance_groups ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []entities.InstanceGroup
+	for rows.Next() {
+		var group entities.InstanceGroup
+		if err := scanGroup(rows, &group); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+func (r *PostgreSQLGroupRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM instance_groups WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("group with ID %s not found", id))
+}
+
+func (r *PostgreSQLGroupRepository) Update(ctx context.Context, group entities.InstanceGroup) error {
+	args, err := groupArgs(group)
+	if err != nil {
+		return err
+	}
+	// groupArgs returns (id, name, config_ids_json, depends_on_json,
+	// shared_env_json, restart_policy_json); move id to the end for the
+	// WHERE clause.
+	args = append(args[1:], args[0])
+
+	query := `
+		UPDATE instance_groups
+		SET name = $1, config_ids_json = $2, depends_on_json = $3, shared_env_json = $4,
+			restart_policy_json = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6`
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("group with ID %s not found", group.ID))
+}
@@ -0,0 +1,127 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// PostgreSQLInstanceRepository is a repositories.InstanceRepository backed
+// by PostgreSQL.
+type PostgreSQLInstanceRepository struct {
+	db *sql.DB
+}
+
+func NewPostgreSQLInstanceRepository(db *sql.DB) *PostgreSQLInstanceRepository {
+	return &PostgreSQLInstanceRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *PostgreSQLInstanceRepository) Initialize() error {
+	return migrations.Run(r.db, "postgres")
+}
+
+func (r *PostgreSQLInstanceRepository) Save(ctx context.Context, instance entities.AIToolInstance) error {
+	args, err := instanceArgs(instance)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO instances (` + instanceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			config_id = excluded.config_id, config_type = excluded.config_type, config_json = excluded.config_json,
+			status = excluded.status, pid = excluded.pid, started_at = excluded.started_at,
+			stopped_at = excluded.stopped_at, exit_code = excluded.exit_code, last_error = excluded.last_error,
+			log_file_path = excluded.log_file_path, restart_attempts = excluded.restart_attempts,
+			next_restart_at = excluded.next_restart_at`
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *PostgreSQLInstanceRepository) FindByID(ctx context.Context, id string) (*entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE id = $1`
+
+	inst, err := scanInstance(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("instance with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &inst, nil
+}
+
+func (r *PostgreSQLInstanceRepository) FindRunning(ctx context.Context) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE status IN ($1, $2, $3) ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query, string(entities.StatusRunning), string(entities.StatusStarting), string(entities.StatusHealthy))
+}
+
+func (r *PostgreSQLInstanceRepository) FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE config_type = $1 ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query, string(toolType))
+}
+
+func (r *PostgreSQLInstanceRepository) FindAll(ctx context.Context) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query)
+}
+
+func (r *PostgreSQLInstanceRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM instances WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("instance with ID %s not found", id))
+}
+
+func (r *PostgreSQLInstanceRepository) Update(ctx context.Context, instance entities.AIToolInstance) error {
+	args, err := instanceArgs(instance)
+	if err != nil {
+		return err
+	}
+	// instanceArgs returns (id, config_id, config_type, config_json, status,
+	// pid, started_at, stopped_at, exit_code, last_error, log_file_path,
+	// restart_attempts, next_restart_at); move id to the end for the WHERE
+	// clause.
+	args = append(args[1:], args[0])
+
+	query := `
+		UPDATE instances
+		SET config_id = $1, config_type = $2, config_json = $3, status = $4, pid = $5,
+			started_at = $6, stopped_at = $7, exit_code = $8, last_error = $9, log_file_path = $10,
+			restart_attempts = $11, next_restart_at = $12
+		WHERE id = $13`
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("instance with ID %s not found", instance.ID))
+}
+
+func (r *PostgreSQLInstanceRepository) queryInstances(ctx context.Context, query string, args ...interface{}) ([]entities.AIToolInstance, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var instances []entities.AIToolInstance
+	for rows.Next() {
+		inst, err := scanInstance(rows)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+
+	return instances, rows.Err()
+}
@@ -0,0 +1,125 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// SQLiteConfigurationRepository is a repositories.ConfigurationRepository
+// backed by SQLite, for single-user Wails deployments that don't have a
+// PostgreSQL server available. It implements the same interface as
+// PostgreSQLConfigurationRepository with the same table layout, differing
+// only where the SQL dialects diverge (placeholder style, upsert syntax).
+type SQLiteConfigurationRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteConfigurationRepository(db *sql.DB) *SQLiteConfigurationRepository {
+	return &SQLiteConfigurationRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *SQLiteConfigurationRepository) Initialize() error {
+	return migrations.Run(r.db, "sqlite")
+}
+
+func (r *SQLiteConfigurationRepository) Save(ctx context.Context, config entities.Configuration) error {
+	args, err := configurationArgs(config)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO configurations (` + configInsertColumns + `, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT(id) DO UPDATE SET
+			name = excluded.name, type = excluded.type, executable_path = excluded.executable_path,
+			working_dir = excluded.working_dir, port = excluded.port, host = excluded.host,
+			arguments_json = excluded.arguments_json, environment_json = excluded.environment_json,
+			auto_start = excluded.auto_start, health_check_json = excluded.health_check_json,
+			restart_policy_json = excluded.restart_policy_json, updated_at = CURRENT_TIMESTAMP`
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *SQLiteConfigurationRepository) FindByID(ctx context.Context, id string) (*entities.Configuration, error) {
+	cfg := &entities.Configuration{}
+	query := `SELECT ` + configColumns + ` FROM configurations WHERE id = ?`
+
+	if err := scanConfiguration(r.db.QueryRowContext(ctx, query, id), cfg); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("configuration with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (r *SQLiteConfigurationRepository) FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.Configuration, error) {
+	query := `SELECT ` + configColumns + ` FROM configurations WHERE type = ? ORDER BY created_at DESC`
+	return r.queryConfigurations(ctx, query, toolType)
+}
+
+func (r *SQLiteConfigurationRepository) FindAll(ctx context.Context) ([]entities.Configuration, error) {
+	query := `SELECT ` + configColumns + ` FROM configurations ORDER BY created_at DESC`
+	return r.queryConfigurations(ctx, query)
+}
+
+func (r *SQLiteConfigurationRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM configurations WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("configuration with ID %s not found", id))
+}
+
+func (r *SQLiteConfigurationRepository) Update(ctx context.Context, config entities.Configuration) error {
+	args, err := configurationArgs(config)
+	if err != nil {
+		return err
+	}
+	// configurationArgs returns (id, name, type, executable_path, working_dir,
+	// port, host, arguments_json, environment_json, auto_start,
+	// health_check_json, restart_policy_json); move id to the end for the
+	// WHERE clause.
+	args = append(args[1:], args[0])
+
+	query := `
+		UPDATE configurations
+		SET name = ?, type = ?, executable_path = ?, working_dir = ?, port = ?, host = ?,
+			arguments_json = ?, environment_json = ?, auto_start = ?, health_check_json = ?,
+			restart_policy_json = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("configuration with ID %s not found", config.ID))
+}
+
+func (r *SQLiteConfigurationRepository) queryConfigurations(ctx context.Context, query string, args ...interface{}) ([]entities.Configuration, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var configs []entities.Configuration
+	for rows.Next() {
+		var cfg entities.Configuration
+		if err := scanConfiguration(rows, &cfg); err != nil {
+			return nil, err
+		}
+		configs = append(configs, cfg)
+	}
+
+	return configs, rows.Err()
+}
@@ -0,0 +1,110 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// SQLiteExecutionRepository is a repositories.ExecutionRepository backed by
+// SQLite. See SQLiteConfigurationRepository for why SQLite and PostgreSQL
+// get separate implementations instead of a shared one with dialect
+// branches.
+type SQLiteExecutionRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteExecutionRepository(db *sql.DB) *SQLiteExecutionRepository {
+	return &SQLiteExecutionRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *SQLiteExecutionRepository) Initialize() error {
+	return migrations.Run(r.db, "sqlite")
+}
+
+func (r *SQLiteExecutionRepository) SaveExecution(ctx context.Context, execution entities.LaunchExecution) error {
+	query := `
+		INSERT INTO launch_executions (` + executionColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			config_id = excluded.config_id, status = excluded.status, total = excluded.total,
+			failed = excluded.failed, succeeded = excluded.succeeded, in_progress = excluded.in_progress,
+			trigger = excluded.trigger, start_time = excluded.start_time, end_time = excluded.end_time`
+
+	_, err := r.db.ExecContext(ctx, query, executionArgs(execution)...)
+	return err
+}
+
+func (r *SQLiteExecutionRepository) FindExecutionByID(ctx context.Context, id string) (*entities.LaunchExecution, error) {
+	query := `SELECT ` + executionColumns + ` FROM launch_executions WHERE id = ?`
+
+	exec, err := scanExecution(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("launch execution with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &exec, nil
+}
+
+func (r *SQLiteExecutionRepository) FindExecutions(ctx context.Context) ([]entities.LaunchExecution, error) {
+	query := `SELECT ` + executionColumns + ` FROM launch_executions ORDER BY start_time DESC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var executions []entities.LaunchExecution
+	for rows.Next() {
+		exec, err := scanExecution(rows)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, exec)
+	}
+
+	return executions, rows.Err()
+}
+
+func (r *SQLiteExecutionRepository) SaveTask(ctx context.Context, task entities.LaunchTask) error {
+	query := `
+		INSERT INTO launch_tasks (` + taskColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			execution_id = excluded.execution_id, sequence = excluded.sequence, kind = excluded.kind,
+			status = excluded.status, start_time = excluded.start_time, end_time = excluded.end_time,
+			error = excluded.error`
+
+	_, err := r.db.ExecContext(ctx, query, taskArgs(task)...)
+	return err
+}
+
+func (r *SQLiteExecutionRepository) FindTasksByExecution(ctx context.Context, executionID string) ([]entities.LaunchTask, error) {
+	query := `SELECT ` + taskColumns + ` FROM launch_tasks WHERE execution_id = ? ORDER BY sequence ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, executionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []entities.LaunchTask
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
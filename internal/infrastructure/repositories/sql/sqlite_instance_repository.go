@@ -0,0 +1,129 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/infrastructure/repositories/sql/migrations"
+)
+
+// SQLiteInstanceRepository is a repositories.InstanceRepository backed by
+// SQLite. See SQLiteConfigurationRepository for why SQLite and PostgreSQL
+// get separate implementations instead of a shared one with dialect
+// branches.
+type SQLiteInstanceRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteInstanceRepository(db *sql.DB) *SQLiteInstanceRepository {
+	return &SQLiteInstanceRepository{db: db}
+}
+
+// Initialize runs any pending migrations, bringing the database schema up
+// to date. It is safe to call on every startup.
+func (r *SQLiteInstanceRepository) Initialize() error {
+	return migrations.Run(r.db, "sqlite")
+}
+
+func (r *SQLiteInstanceRepository) Save(ctx context.Context, instance entities.AIToolInstance) error {
+	args, err := instanceArgs(instance)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO instances (` + instanceColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			config_id = excluded.config_id, config_type = excluded.config_type, config_json = excluded.config_json,
+			status = excluded.status, pid = excluded.pid, started_at = excluded.started_at,
+			stopped_at = excluded.stopped_at, exit_code = excluded.exit_code, last_error = excluded.last_error,
+			log_file_path = excluded.log_file_path, restart_attempts = excluded.restart_attempts,
+			next_restart_at = excluded.next_restart_at`
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *SQLiteInstanceRepository) FindByID(ctx context.Context, id string) (*entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE id = ?`
+
+	inst, err := scanInstance(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("instance with ID %s not found", id)
+		}
+		return nil, err
+	}
+
+	return &inst, nil
+}
+
+func (r *SQLiteInstanceRepository) FindRunning(ctx context.Context) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE status IN (?, ?, ?) ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query, string(entities.StatusRunning), string(entities.StatusStarting), string(entities.StatusHealthy))
+}
+
+func (r *SQLiteInstanceRepository) FindByType(ctx context.Context, toolType entities.ToolType) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances WHERE config_type = ? ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query, string(toolType))
+}
+
+func (r *SQLiteInstanceRepository) FindAll(ctx context.Context) ([]entities.AIToolInstance, error) {
+	query := `SELECT ` + instanceColumns + ` FROM instances ORDER BY started_at DESC`
+	return r.queryInstances(ctx, query)
+}
+
+func (r *SQLiteInstanceRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM instances WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("instance with ID %s not found", id))
+}
+
+func (r *SQLiteInstanceRepository) Update(ctx context.Context, instance entities.AIToolInstance) error {
+	args, err := instanceArgs(instance)
+	if err != nil {
+		return err
+	}
+	// instanceArgs returns (id, config_id, config_type, config_json, status,
+	// pid, started_at, stopped_at, exit_code, last_error, log_file_path,
+	// restart_attempts, next_restart_at); move id to the end for the WHERE
+	// clause.
+	args = append(args[1:], args[0])
+
+	query := `
+		UPDATE instances
+		SET config_id = ?, config_type = ?, config_json = ?, status = ?, pid = ?,
+			started_at = ?, stopped_at = ?, exit_code = ?, last_error = ?, log_file_path = ?,
+			restart_attempts = ?, next_restart_at = ?
+		WHERE id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	return requireRowsAffected(result, fmt.Errorf("instance with ID %s not found", instance.ID))
+}
+
+func (r *SQLiteInstanceRepository) queryInstances(ctx context.Context, query string, args ...interface{}) ([]entities.AIToolInstance, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var instances []entities.AIToolInstance
+	for rows.Next() {
+		inst, err := scanInstance(rows)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, inst)
+	}
+
+	return instances, rows.Err()
+}
@@ -0,0 +1,48 @@
+package impl
+
+import (
+	"sync"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+)
+
+// AdapterRegistry is an in-memory services.AdapterRegistry.
+type AdapterRegistry struct {
+	mu       sync.RWMutex
+	adapters map[entities.ToolType]services.ToolAdapter
+}
+
+// NewAdapterRegistry creates an empty adapter registry.
+func NewAdapterRegistry() *AdapterRegistry {
+	return &AdapterRegistry{
+		adapters: make(map[entities.ToolType]services.ToolAdapter),
+	}
+}
+
+// Register implements services.AdapterRegistry.
+func (r *AdapterRegistry) Register(adapter services.ToolAdapter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.adapters[adapter.Type()] = adapter
+}
+
+// Get implements services.AdapterRegistry.
+func (r *AdapterRegistry) Get(toolType entities.ToolType) (services.ToolAdapter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	adapter, ok := r.adapters[toolType]
+	return adapter, ok
+}
+
+// List implements services.AdapterRegistry.
+func (r *AdapterRegistry) List() []entities.ToolType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]entities.ToolType, 0, len(r.adapters))
+	for t := range r.adapters {
+		types = append(types, t)
+	}
+	return types
+}
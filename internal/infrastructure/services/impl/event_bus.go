@@ -0,0 +1,90 @@
+package impl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ai-launcher/internal/domain/services"
+)
+
+// eventBufferSize bounds how many recent events InstanceEventBus replays to
+// a subscriber that joins mid-lifecycle.
+const eventBufferSize = 500
+
+// eventSubscriberBuffer bounds a subscriber channel's backlog before new
+// events are dropped rather than blocking Publish.
+const eventSubscriberBuffer = 256
+
+// InstanceEventBus is an in-process, in-memory services.InstanceEventBus. It
+// keeps a ring buffer of recent events so a subscriber that joins after an
+// instance has already transitioned still sees its recent history.
+type InstanceEventBus struct {
+	mu          sync.Mutex
+	buffer      []services.InstanceEvent
+	subscribers map[chan services.InstanceEvent]services.InstanceEventFilter
+}
+
+// NewInstanceEventBus creates a new in-memory instance event bus.
+func NewInstanceEventBus() *InstanceEventBus {
+	return &InstanceEventBus{
+		subscribers: make(map[chan services.InstanceEvent]services.InstanceEventFilter),
+	}
+}
+
+// Publish broadcasts event to every subscriber whose filter matches it and
+// records it in the replay buffer.
+func (b *InstanceEventBus) Publish(event services.InstanceEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buffer = append(b.buffer, event)
+	if len(b.buffer) > eventBufferSize {
+		b.buffer = b.buffer[len(b.buffer)-eventBufferSize:]
+	}
+
+	for ch, filter := range b.subscribers {
+		if !filter.Match(event) {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			// slow subscriber; drop the event rather than block Publish
+		}
+	}
+}
+
+// Subscribe returns a channel pre-seeded with buffered events matching
+// filter, then fed with newly published matching events until ctx is
+// cancelled.
+func (b *InstanceEventBus) Subscribe(ctx context.Context, filter services.InstanceEventFilter) <-chan services.InstanceEvent {
+	ch := make(chan services.InstanceEvent, eventSubscriberBuffer)
+
+	b.mu.Lock()
+	var replay []services.InstanceEvent
+	for _, event := range b.buffer {
+		if filter.Match(event) {
+			replay = append(replay, event)
+		}
+	}
+	// only the newest matching events that fit in ch are replayed, so the
+	// sends below never block while b.mu is held
+	if len(replay) > eventSubscriberBuffer {
+		replay = replay[len(replay)-eventSubscriberBuffer:]
+	}
+	for _, event := range replay {
+		ch <- event
+	}
+	b.subscribers[ch] = filter
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+		close(ch)
+	}()
+
+	return ch
+}
@@ -0,0 +1,682 @@
+package impl
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/fsnotify/fsnotify"
+)
+
+// logEntryBufferSize bounds how many recent entries LogService keeps in
+// memory per instance for ReadLogs, mirroring ToolManager's log ring buffer.
+const logEntryBufferSize = 10000
+
+// defaultMaxLogSizeBytes bounds how large an instance's current log file may
+// grow before record rotates it, unless NewLogService is given an override.
+const defaultMaxLogSizeBytes = 10 * 1024 * 1024
+
+// defaultMaxLogBackups bounds how many gzip-compressed rotated backups are
+// kept per instance before the oldest is deleted, unless NewLogService is
+// given an override.
+const defaultMaxLogBackups = 5
+
+// tracebackHeader marks the start of a Python traceback in raw process
+// output; StreamProcessOutput coalesces it and every line beneath it, up
+// through the exception summary line, into a single LogEntry.
+const tracebackHeader = "Traceback (most recent call last):"
+
+// levelColor maps a LogLevel to its ANSI color code for LogFormatText output.
+var levelColor = map[services.LogLevel]string{
+	services.LevelTrace: "\033[90m", // gray
+	services.LevelDebug: "\033[36m", // cyan
+	services.LevelInfo:  "\033[32m", // green
+	services.LevelWarn:  "\033[33m", // yellow
+	services.LevelError: "\033[31m", // red
+}
+
+const colorReset = "\033[0m"
+
+// LogService implements services.LogService, persisting structured log
+// entries to one file per instance under ~/.ai-launcher/logs and buffering
+// the most recent bufferCap entries per instance for ReadLogs.
+type LogService struct {
+	mu             sync.Mutex
+	logDir         string
+	format         services.LogFormat
+	minLevel       services.LogLevel
+	bufferCap      int
+	maxSizeBytes   int64
+	maxBackups     int
+	entries        map[string][]services.LogEntry
+	instanceLevels map[string]services.LogLevel
+	root           *logger
+}
+
+// NewLogService creates a log service that renders entries in format
+// (LogFormatJSON or LogFormatText) and discards anything below minLevel.
+// bufferCap bounds how many recent entries are kept in memory per instance
+// for ReadLogs/Tail; 0 defaults to logEntryBufferSize. maxSizeBytes bounds
+// how large an instance's log file may grow before it's rotated; 0 defaults
+// to defaultMaxLogSizeBytes. maxBackups bounds how many gzip-compressed
+// rotated backups are kept per instance; 0 defaults to defaultMaxLogBackups.
+func NewLogService(format services.LogFormat, minLevel services.LogLevel, bufferCap int, maxSizeBytes int64, maxBackups int) *LogService {
+	homeDir, _ := os.UserHomeDir()
+	logDir := filepath.Join(homeDir, ".ai-launcher", "logs")
+	os.MkdirAll(logDir, 0755)
+
+	if bufferCap <= 0 {
+		bufferCap = logEntryBufferSize
+	}
+	if maxSizeBytes <= 0 {
+		maxSizeBytes = defaultMaxLogSizeBytes
+	}
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxLogBackups
+	}
+
+	ls := &LogService{
+		logDir:         logDir,
+		format:         format,
+		minLevel:       minLevel,
+		bufferCap:      bufferCap,
+		maxSizeBytes:   maxSizeBytes,
+		maxBackups:     maxBackups,
+		entries:        make(map[string][]services.LogEntry),
+		instanceLevels: make(map[string]services.LogLevel),
+	}
+	ls.root = &logger{service: ls}
+	return ls
+}
+
+// Logger returns the service's root logger.
+func (ls *LogService) Logger() services.Logger {
+	return ls.root
+}
+
+// WriteLogEntry persists entry directly, bypassing the Logger key/value API.
+func (ls *LogService) WriteLogEntry(entry services.LogEntry) error {
+	return ls.record(entry)
+}
+
+// SetInstanceLevel overrides the minimum level recorded for instanceID,
+// independent of ls.minLevel.
+func (ls *LogService) SetInstanceLevel(instanceID string, level services.LogLevel) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.instanceLevels[instanceID] = level
+}
+
+// effectiveMinLevel returns the minimum level to record for instanceID: its
+// override set via SetInstanceLevel, if any, otherwise ls.minLevel.
+func (ls *LogService) effectiveMinLevel(instanceID string) services.LogLevel {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if level, ok := ls.instanceLevels[instanceID]; ok {
+		return level
+	}
+	return ls.minLevel
+}
+
+// StreamProcessOutput scans stdout and stderr concurrently, classifying and
+// persisting each line (or coalesced traceback) as a LogEntry tagged with
+// instanceID. It returns once both readers are exhausted.
+func (ls *LogService) StreamProcessOutput(instanceID string, stdout, stderr io.Reader) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); ls.scanClassified(instanceID, stdout) }()
+	go func() { defer wg.Done(); ls.scanClassified(instanceID, stderr) }()
+	wg.Wait()
+}
+
+// scanClassified reads r line-by-line, classifying each line's LogLevel
+// heuristically and coalescing a Python traceback (everything from
+// tracebackHeader through its unindented exception summary line) into one
+// entry, writing each resulting entry via WriteLogEntry.
+func (ls *LogService) scanClassified(instanceID string, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var traceback []string
+	flush := func() {
+		if len(traceback) == 0 {
+			return
+		}
+		ls.WriteLogEntry(services.LogEntry{
+			Timestamp:  time.Now(),
+			Level:      services.LevelError,
+			Message:    strings.Join(traceback, "\n"),
+			InstanceID: instanceID,
+		})
+		traceback = nil
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if len(traceback) > 0 {
+			traceback = append(traceback, line)
+			// the traceback ends at the exception summary line, which (unlike
+			// every frame line above it) isn't indented
+			if line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+				flush()
+			}
+			continue
+		}
+
+		if strings.Contains(line, tracebackHeader) {
+			traceback = append(traceback, line)
+			continue
+		}
+
+		ls.WriteLogEntry(services.LogEntry{
+			Timestamp:  time.Now(),
+			Level:      classifyLevel(line),
+			Message:    line,
+			InstanceID: instanceID,
+		})
+	}
+	flush()
+}
+
+// classifyLevel heuristically infers a LogLevel from a raw process output
+// line's common prefix (e.g. "ERROR:", "[WARN]", "WARNING:"), defaulting to
+// LevelInfo when nothing matches.
+func classifyLevel(line string) services.LogLevel {
+	upper := strings.ToUpper(strings.TrimSpace(line))
+	switch {
+	case hasAnyPrefix(upper, "ERROR", "[ERROR]", "FATAL", "[FATAL]"):
+		return services.LevelError
+	case hasAnyPrefix(upper, "WARN", "[WARN]", "WARNING", "[WARNING]"):
+		return services.LevelWarn
+	case hasAnyPrefix(upper, "DEBUG", "[DEBUG]"):
+		return services.LevelDebug
+	case hasAnyPrefix(upper, "TRACE", "[TRACE]"):
+		return services.LevelTrace
+	default:
+		return services.LevelInfo
+	}
+}
+
+// hasAnyPrefix reports whether s starts with any of prefixes.
+func hasAnyPrefix(s string, prefixes ...string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadLogs returns the most recent buffered entries for instanceID that are
+// at least minLevel, oldest first.
+func (ls *LogService) ReadLogs(instanceID string, lines int, minLevel services.LogLevel) ([]services.LogEntry, error) {
+	ls.mu.Lock()
+	entries := make([]services.LogEntry, len(ls.entries[instanceID]))
+	copy(entries, ls.entries[instanceID])
+	ls.mu.Unlock()
+
+	entries = filterByLevel(entries, minLevel)
+	if lines > 0 && len(entries) > lines {
+		entries = entries[len(entries)-lines:]
+	}
+
+	return entries, nil
+}
+
+// filterByLevel returns the entries in entries at least minLevel, preserving
+// order.
+func filterByLevel(entries []services.LogEntry, minLevel services.LogLevel) []services.LogEntry {
+	if minLevel <= services.LevelTrace {
+		return entries
+	}
+
+	filtered := entries[:0:0]
+	for _, e := range entries {
+		if e.Level >= minLevel {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// RotateLogs rotates an instance's current log file into a new
+// gzip-compressed backup, pruning backups beyond ls.maxBackups, so a fresh
+// log file is started on its next write. The same rotation also happens
+// automatically from record once a file exceeds ls.maxSizeBytes.
+func (ls *LogService) RotateLogs(instanceID string) error {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.rotateLocked(instanceID)
+}
+
+// rotateLocked does the work of RotateLogs. Callers must hold ls.mu.
+func (ls *LogService) rotateLocked(instanceID string) error {
+	logFile := ls.logFilePath(instanceID)
+	if _, err := os.Stat(logFile); os.IsNotExist(err) {
+		return nil
+	}
+
+	backup := fmt.Sprintf("%s.%s.gz", logFile, time.Now().Format("20060102-150405"))
+	if err := gzipFile(logFile, backup); err != nil {
+		return fmt.Errorf("failed to compress rotated log file: %w", err)
+	}
+	if err := os.Remove(logFile); err != nil {
+		return fmt.Errorf("failed to remove rotated log file: %w", err)
+	}
+
+	return ls.pruneBackupsLocked(instanceID)
+}
+
+// pruneBackupsLocked deletes instanceID's oldest gzip-compressed backups
+// beyond ls.maxBackups. Callers must hold ls.mu.
+func (ls *LogService) pruneBackupsLocked(instanceID string) error {
+	backups, err := filepath.Glob(ls.logFilePath(instanceID) + ".*.gz")
+	if err != nil {
+		return fmt.Errorf("failed to list rotated log backups: %w", err)
+	}
+	sort.Strings(backups) // the embedded "20060102-150405" timestamp sorts chronologically as a string
+
+	if len(backups) <= ls.maxBackups {
+		return nil
+	}
+	for _, backup := range backups[:len(backups)-ls.maxBackups] {
+		if err := os.Remove(backup); err != nil {
+			return fmt.Errorf("failed to remove old log backup %s: %w", backup, err)
+		}
+	}
+	return nil
+}
+
+// gzipFile compresses src into a new file at dst, leaving src untouched.
+func gzipFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	if _, err := io.Copy(gz, in); err != nil {
+		gz.Close()
+		return err
+	}
+	return gz.Close()
+}
+
+// CleanupLogs removes log files (current and rotated) untouched for more
+// than olderThanDays.
+func (ls *LogService) CleanupLogs(olderThanDays int) error {
+	cutoff := time.Now().AddDate(0, 0, -olderThanDays)
+
+	return filepath.Walk(ls.logDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && info.ModTime().Before(cutoff) {
+			return os.Remove(path)
+		}
+		return nil
+	})
+}
+
+// Tail streams LogEntries from an instance's log file, similar to
+// hpcloud/tail: with follow false it just reads whatever's already on disk
+// and closes the channel; with follow true it seeks to the end of the file
+// first and uses fsnotify to stream only entries written after Tail was
+// called, until cancel is called.
+func (ls *LogService) Tail(instanceID string, follow bool) (<-chan services.LogEntry, func() error, error) {
+	path := ls.logFilePath(instanceID)
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	out := make(chan services.LogEntry, ls.bufferCap)
+
+	if !follow {
+		go func() {
+			defer close(out)
+			defer file.Close()
+			ls.drainLines(file, out, nil)
+		}()
+		return out, func() error { return nil }, nil
+	}
+
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to seek to end of log file: %w", err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to create log watcher: %w", err)
+	}
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to watch log file: %w", err)
+	}
+
+	done := make(chan struct{})
+	var closeOnce sync.Once
+	cancel := func() error {
+		closeOnce.Do(func() { close(done) })
+		watcher.Close()
+		return file.Close()
+	}
+
+	go func() {
+		defer close(out)
+		reader := bufio.NewReader(file)
+
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+					continue
+				}
+				ls.drainLines(reader, out, done)
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, cancel, nil
+}
+
+// drainLines reads complete lines from r, decodes each into a LogEntry, and
+// sends it on out, stopping at EOF (the rest of the last, possibly partial,
+// line is left for the next read). Send is abandoned early if done fires.
+func (ls *LogService) drainLines(r io.Reader, out chan<- services.LogEntry, done <-chan struct{}) {
+	reader, ok := r.(*bufio.Reader)
+	if !ok {
+		reader = bufio.NewReader(r)
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			entry, ok := ls.decodeLine(line)
+			if ok {
+				select {
+				case out <- entry:
+				case <-done:
+					return
+				}
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// decodeLine reconstructs a LogEntry from one persisted log line. JSON
+// format round-trips exactly; text format is colorized for a terminal and
+// can't be parsed back structurally, so it's wrapped as a single Info-level
+// entry carrying the rendered line as its message.
+func (ls *LogService) decodeLine(line string) (services.LogEntry, bool) {
+	line = strings.TrimRight(line, "\n")
+	if line == "" {
+		return services.LogEntry{}, false
+	}
+
+	if ls.format != services.LogFormatJSON {
+		return services.LogEntry{Timestamp: time.Now(), Level: services.LevelInfo, Message: line}, true
+	}
+
+	var decoded jsonLogLine
+	if err := json.Unmarshal([]byte(line), &decoded); err != nil {
+		return services.LogEntry{}, false
+	}
+
+	timestamp, _ := time.Parse(time.RFC3339Nano, decoded.Timestamp)
+	return services.LogEntry{
+		Timestamp:  timestamp,
+		Level:      parseLevel(decoded.Level),
+		Message:    decoded.Message,
+		Logger:     decoded.Logger,
+		Caller:     decoded.Caller,
+		InstanceID: decoded.InstanceID,
+		ToolType:   decoded.ToolType,
+		Fields:     decoded.Fields,
+	}, true
+}
+
+// parseLevel is the inverse of LogLevel.String, defaulting to LevelInfo for
+// anything unrecognized.
+func parseLevel(level string) services.LogLevel {
+	switch level {
+	case "trace":
+		return services.LevelTrace
+	case "debug":
+		return services.LevelDebug
+	case "warn":
+		return services.LevelWarn
+	case "error":
+		return services.LevelError
+	default:
+		return services.LevelInfo
+	}
+}
+
+// logFilePath returns the on-disk log file for instanceID, or the shared
+// "_global.log" file for entries with no instance ID (e.g. system-level
+// logging from use cases that aren't scoped to one instance).
+func (ls *LogService) logFilePath(instanceID string) string {
+	name := instanceID
+	if name == "" {
+		name = "_global"
+	}
+	return filepath.Join(ls.logDir, name+".log")
+}
+
+// record appends entry to its instance's in-memory ring buffer and writes
+// it to that instance's log file in the configured format, rotating the
+// file first if it has grown past ls.maxSizeBytes. Entries below
+// effectiveMinLevel for entry.InstanceID are silently dropped.
+func (ls *LogService) record(entry services.LogEntry) error {
+	if entry.Level < ls.effectiveMinLevel(entry.InstanceID) {
+		return nil
+	}
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	bucket := append(ls.entries[entry.InstanceID], entry)
+	if len(bucket) > ls.bufferCap {
+		bucket = bucket[len(bucket)-ls.bufferCap:]
+	}
+	ls.entries[entry.InstanceID] = bucket
+
+	line := ls.render(entry)
+	path := ls.logFilePath(entry.InstanceID)
+
+	if info, err := os.Stat(path); err == nil && info.Size()+int64(len(line)) > ls.maxSizeBytes {
+		if err := ls.rotateLocked(entry.InstanceID); err != nil {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(line); err != nil {
+		return fmt.Errorf("failed to write log line: %w", err)
+	}
+	return nil
+}
+
+// render formats entry per ls.format.
+func (ls *LogService) render(entry services.LogEntry) string {
+	if ls.format == services.LogFormatJSON {
+		return renderJSON(entry)
+	}
+	return renderText(entry)
+}
+
+// jsonLogLine mirrors LogEntry with the exact key names the JSON output
+// format contract promises: @timestamp, @level, @message, instance_id,
+// tool_type, plus caller info and any remaining fields.
+type jsonLogLine struct {
+	Timestamp  string                 `json:"@timestamp"`
+	Level      string                 `json:"@level"`
+	Message    string                 `json:"@message"`
+	Logger     string                 `json:"logger,omitempty"`
+	Caller     string                 `json:"caller,omitempty"`
+	InstanceID string                 `json:"instance_id,omitempty"`
+	ToolType   string                 `json:"tool_type,omitempty"`
+	Fields     map[string]interface{} `json:"fields,omitempty"`
+}
+
+func renderJSON(entry services.LogEntry) string {
+	line := jsonLogLine{
+		Timestamp:  entry.Timestamp.Format(time.RFC3339Nano),
+		Level:      entry.Level.String(),
+		Message:    entry.Message,
+		Logger:     entry.Logger,
+		Caller:     entry.Caller,
+		InstanceID: entry.InstanceID,
+		ToolType:   entry.ToolType,
+		Fields:     entry.Fields,
+	}
+	encoded, err := json.Marshal(line)
+	if err != nil {
+		return fmt.Sprintf(`{"@message":"failed to encode log entry: %s"}`+"\n", err.Error())
+	}
+	return string(encoded) + "\n"
+}
+
+func renderText(entry services.LogEntry) string {
+	var b strings.Builder
+	b.WriteString(levelColor[entry.Level])
+	fmt.Fprintf(&b, "%s [%-5s]", entry.Timestamp.Format(time.RFC3339), strings.ToUpper(entry.Level.String()))
+	b.WriteString(colorReset)
+
+	if entry.Logger != "" {
+		fmt.Fprintf(&b, " %s:", entry.Logger)
+	}
+	fmt.Fprintf(&b, " %s", entry.Message)
+
+	if entry.InstanceID != "" {
+		fmt.Fprintf(&b, " instance_id=%s", entry.InstanceID)
+	}
+	if entry.ToolType != "" {
+		fmt.Fprintf(&b, " tool_type=%s", entry.ToolType)
+	}
+	for k, v := range entry.Fields {
+		fmt.Fprintf(&b, " %s=%v", k, v)
+	}
+	if entry.Caller != "" {
+		fmt.Fprintf(&b, " (%s)", entry.Caller)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// logger implements services.Logger, writing through to a LogService.
+type logger struct {
+	service *LogService
+	name    string
+	kv      []interface{}
+}
+
+func (l *logger) Trace(msg string, kv ...interface{}) { l.log(services.LevelTrace, msg, kv) }
+func (l *logger) Debug(msg string, kv ...interface{}) { l.log(services.LevelDebug, msg, kv) }
+func (l *logger) Info(msg string, kv ...interface{})  { l.log(services.LevelInfo, msg, kv) }
+func (l *logger) Warn(msg string, kv ...interface{})  { l.log(services.LevelWarn, msg, kv) }
+func (l *logger) Error(msg string, kv ...interface{}) { l.log(services.LevelError, msg, kv) }
+
+func (l *logger) With(kv ...interface{}) services.Logger {
+	return &logger{service: l.service, name: l.name, kv: append(append([]interface{}{}, l.kv...), kv...)}
+}
+
+func (l *logger) Named(name string) services.Logger {
+	full := name
+	if l.name != "" {
+		full = l.name + "." + name
+	}
+	return &logger{service: l.service, name: full, kv: l.kv}
+}
+
+func (l *logger) Name() string {
+	return l.name
+}
+
+// log builds and records a LogEntry, promoting the well-known "instance_id"
+// and "tool_type" keys to LogEntry's dedicated fields and leaving everything
+// else in Fields.
+func (l *logger) log(level services.LogLevel, msg string, kv []interface{}) {
+	all := append(append([]interface{}{}, l.kv...), kv...)
+
+	entry := services.LogEntry{
+		Timestamp: time.Now(),
+		Level:     level,
+		Message:   msg,
+		Logger:    l.name,
+		Caller:    callerInfo(3),
+		Fields:    make(map[string]interface{}),
+	}
+
+	for i := 0; i+1 < len(all); i += 2 {
+		key, ok := all[i].(string)
+		if !ok {
+			continue
+		}
+		value := all[i+1]
+		switch key {
+		case "instance_id":
+			entry.InstanceID = fmt.Sprintf("%v", value)
+		case "tool_type":
+			entry.ToolType = fmt.Sprintf("%v", value)
+		default:
+			entry.Fields[key] = value
+		}
+	}
+
+	l.service.record(entry)
+}
+
+// callerInfo returns "file:line" for the caller skip frames up from here,
+// trimmed to the last two path segments so output stays readable.
+func callerInfo(skip int) string {
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(file, string(os.PathSeparator))
+	if len(parts) > 2 {
+		parts = parts[len(parts)-2:]
+	}
+	return fmt.Sprintf("%s:%d", strings.Join(parts, string(os.PathSeparator)), line)
+}
@@ -0,0 +1,200 @@
+package impl
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// portOwner reports the process currently bound to port by inspecting the
+// host's TCP connection table, rather than racing a bind-probe against
+// whatever else might grab the port in between. It returns nil, nil if the
+// port is not currently bound by anything.
+func portOwner(port int) (*entities.PortOwner, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return portOwnerLinux(port)
+	case "windows":
+		return portOwnerNetstatWindows(port)
+	default:
+		return portOwnerLsof(port)
+	}
+}
+
+// portOwnerLinux scans /proc/net/tcp and /proc/net/tcp6 for a listening
+// socket on port, then walks /proc/*/fd to match its inode back to an
+// owning PID.
+func portOwnerLinux(port int) (*entities.PortOwner, error) {
+	inode, err := findListeningInode(port, "/proc/net/tcp")
+	if err != nil {
+		return nil, err
+	}
+	if inode == "" {
+		inode, err = findListeningInode(port, "/proc/net/tcp6")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if inode == "" {
+		return nil, nil
+	}
+
+	pid, err := findPIDForSocketInode(inode)
+	if err != nil || pid == 0 {
+		return nil, err
+	}
+
+	name := processNameLinux(pid)
+	return &entities.PortOwner{Port: port, PID: pid, ProcessName: name}, nil
+}
+
+// findListeningInode parses a /proc/net/tcp{,6}-formatted file for a socket
+// in LISTEN state (hex "0A") bound to port, returning its inode.
+func findListeningInode(port int, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer f.Close()
+
+	wantPort := fmt.Sprintf("%04X", port) // the file zero-pads ports to 4 hex digits
+
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // skip header
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		localAddr := fields[1] // "<address>:<port-hex>"
+		state := fields[3]     // "0A" == TCP_LISTEN
+		parts := strings.Split(localAddr, ":")
+		if len(parts) != 2 {
+			continue
+		}
+		if state == "0A" && strings.EqualFold(parts[1], wantPort) {
+			return fields[9], nil // inode
+		}
+	}
+	return "", scanner.Err()
+}
+
+// findPIDForSocketInode walks /proc/*/fd looking for a symlink pointing at
+// socket:[inode], returning the owning PID.
+func findPIDForSocketInode(inode string) (int, error) {
+	procDirs, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read /proc: %w", err)
+	}
+	target := "socket:[" + inode + "]"
+
+	for _, d := range procDirs {
+		pid, err := strconv.Atoi(d.Name())
+		if err != nil {
+			continue
+		}
+		fdDir := filepath.Join("/proc", d.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue // process exited or unreadable; not an error worth surfacing
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil {
+				continue
+			}
+			if link == target {
+				return pid, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+// processNameLinux reads the short command name for pid, returning "" if it
+// can't be determined.
+func processNameLinux(pid int) string {
+	data, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// portOwnerLsof shells out to lsof, the standard tool for this on macOS
+// (and most other non-Linux Unixes), to find the PID bound to port.
+func portOwnerLsof(port int) (*entities.PortOwner, error) {
+	out, err := exec.Command("lsof", "-n", "-P", "-iTCP:"+strconv.Itoa(port), "-sTCP:LISTEN").Output()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return nil, nil // lsof exits non-zero when nothing matches
+		}
+		return nil, fmt.Errorf("failed to run lsof: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) < 2 {
+		return nil, nil
+	}
+	fields := strings.Fields(lines[1]) // first data row, after the header
+	if len(fields) < 2 {
+		return nil, nil
+	}
+	pid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, nil
+	}
+	return &entities.PortOwner{Port: port, PID: pid, ProcessName: fields[0]}, nil
+}
+
+// portOwnerNetstatWindows shells out to netstat -ano, the most portable way
+// to map a listening port to a PID on Windows without depending on the
+// iphlpapi GetExtendedTcpTable API and its accompanying cgo/syscall bindings.
+func portOwnerNetstatWindows(port int) (*entities.PortOwner, error) {
+	out, err := exec.Command("netstat", "-ano", "-p", "tcp").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run netstat: %w", err)
+	}
+
+	suffix := ":" + strconv.Itoa(port)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 || fields[0] != "TCP" {
+			continue
+		}
+		localAddr, state, pidField := fields[1], fields[3], fields[4]
+		if state != "LISTENING" || !strings.HasSuffix(localAddr, suffix) {
+			continue
+		}
+		pid, err := strconv.Atoi(pidField)
+		if err != nil {
+			continue
+		}
+		return &entities.PortOwner{Port: port, PID: pid, ProcessName: processNameWindows(pid)}, nil
+	}
+	return nil, nil
+}
+
+// processNameWindows resolves pid to an image name via tasklist, returning
+// "" if it can't be determined.
+func processNameWindows(pid int) string {
+	out, err := exec.Command("tasklist", "/FI", fmt.Sprintf("PID eq %d", pid), "/FO", "CSV", "/NH").Output()
+	if err != nil {
+		return ""
+	}
+	fields := strings.Split(strings.TrimSpace(string(out)), ",")
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.Trim(fields[0], "\"")
+}
@@ -0,0 +1,150 @@
+package impl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// ServiceUnitService generates init-system unit definitions for a
+// Configuration, modeled on `podman generate systemd`: the output is meant
+// to be reviewed and installed by the operator, not written to disk
+// automatically.
+type ServiceUnitService struct{}
+
+// NewServiceUnitService creates a new service unit generator.
+func NewServiceUnitService() *ServiceUnitService {
+	return &ServiceUnitService{}
+}
+
+// Generate renders cfg as a unit definition in the format selected by
+// opts.Format.
+func (s *ServiceUnitService) Generate(cfg entities.Configuration, opts entities.ServiceUnitOptions) (string, error) {
+	switch opts.Format {
+	case entities.ServiceUnitSystemd:
+		return generateSystemdUnit(cfg, opts), nil
+	case entities.ServiceUnitLaunchd:
+		return generateLaunchdPlist(cfg, opts), nil
+	case entities.ServiceUnitWindows:
+		return generateWindowsServiceScript(cfg, opts), nil
+	default:
+		return "", fmt.Errorf("unsupported service unit format: %q", opts.Format)
+	}
+}
+
+// commandLine joins the configured executable and its arguments into a
+// single shell-safe command line, quoting any argument containing whitespace.
+func commandLine(cfg entities.Configuration) string {
+	parts := []string{cfg.ExecutablePath}
+	for _, arg := range cfg.Arguments {
+		if strings.ContainsAny(arg, " \t") {
+			arg = fmt.Sprintf("%q", arg)
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// description returns opts.Description if set, else a sensible default
+// derived from cfg.Name.
+func description(cfg entities.Configuration, opts entities.ServiceUnitOptions) string {
+	if opts.Description != "" {
+		return opts.Description
+	}
+	return fmt.Sprintf("%s (managed by ai-launcher)", cfg.Name)
+}
+
+// generateSystemdUnit renders a [Unit]/[Service]/[Install] systemd unit file.
+func generateSystemdUnit(cfg entities.Configuration, opts entities.ServiceUnitOptions) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "[Unit]\n")
+	fmt.Fprintf(&b, "Description=%s\n", description(cfg, opts))
+	fmt.Fprintf(&b, "After=network-online.target\n")
+	fmt.Fprintf(&b, "Wants=network-online.target\n\n")
+
+	fmt.Fprintf(&b, "[Service]\n")
+	fmt.Fprintf(&b, "ExecStart=%s\n", commandLine(cfg))
+	if cfg.WorkingDir != "" {
+		fmt.Fprintf(&b, "WorkingDirectory=%s\n", cfg.WorkingDir)
+	}
+	if opts.User != "" {
+		fmt.Fprintf(&b, "User=%s\n", opts.User)
+	}
+	for key, value := range cfg.Environment {
+		fmt.Fprintf(&b, "Environment=%s=%s\n", key, value)
+	}
+	if opts.Restart {
+		fmt.Fprintf(&b, "Restart=on-failure\n")
+		fmt.Fprintf(&b, "RestartSec=5\n")
+	}
+	fmt.Fprintf(&b, "\n[Install]\n")
+	fmt.Fprintf(&b, "WantedBy=multi-user.target\n")
+
+	return b.String()
+}
+
+// generateLaunchdPlist renders a macOS launchd property list.
+func generateLaunchdPlist(cfg entities.Configuration, opts entities.ServiceUnitOptions) string {
+	label := fmt.Sprintf("com.ai-launcher.%s", cfg.ID)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	fmt.Fprintf(&b, "<!DOCTYPE plist PUBLIC \"-//Apple//DTD PLIST 1.0//EN\" \"http://www.apple.com/DTDs/PropertyList-1.0.dtd\">\n")
+	fmt.Fprintf(&b, "<plist version=\"1.0\">\n<dict>\n")
+	fmt.Fprintf(&b, "\t<key>Label</key>\n\t<string>%s</string>\n", label)
+
+	fmt.Fprintf(&b, "\t<key>ProgramArguments</key>\n\t<array>\n")
+	fmt.Fprintf(&b, "\t\t<string>%s</string>\n", cfg.ExecutablePath)
+	for _, arg := range cfg.Arguments {
+		fmt.Fprintf(&b, "\t\t<string>%s</string>\n", arg)
+	}
+	fmt.Fprintf(&b, "\t</array>\n")
+
+	if cfg.WorkingDir != "" {
+		fmt.Fprintf(&b, "\t<key>WorkingDirectory</key>\n\t<string>%s</string>\n", cfg.WorkingDir)
+	}
+	if opts.User != "" {
+		fmt.Fprintf(&b, "\t<key>UserName</key>\n\t<string>%s</string>\n", opts.User)
+	}
+	if len(cfg.Environment) > 0 {
+		fmt.Fprintf(&b, "\t<key>EnvironmentVariables</key>\n\t<dict>\n")
+		for key, value := range cfg.Environment {
+			fmt.Fprintf(&b, "\t\t<key>%s</key>\n\t\t<string>%s</string>\n", key, value)
+		}
+		fmt.Fprintf(&b, "\t</dict>\n")
+	}
+	if opts.Restart {
+		fmt.Fprintf(&b, "\t<key>KeepAlive</key>\n\t<true/>\n")
+	}
+	fmt.Fprintf(&b, "\t<key>RunAtLoad</key>\n\t<true/>\n")
+	fmt.Fprintf(&b, "</dict>\n</plist>\n")
+
+	return b.String()
+}
+
+// generateWindowsServiceScript renders an NSSM install script, the de facto
+// standard for running arbitrary executables as Windows services.
+func generateWindowsServiceScript(cfg entities.Configuration, opts entities.ServiceUnitOptions) string {
+	serviceName := fmt.Sprintf("ai-launcher-%s", cfg.ID)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "nssm install %s %q\n", serviceName, cfg.ExecutablePath)
+	if len(cfg.Arguments) > 0 {
+		fmt.Fprintf(&b, "nssm set %s AppParameters %q\n", serviceName, strings.Join(cfg.Arguments, " "))
+	}
+	if cfg.WorkingDir != "" {
+		fmt.Fprintf(&b, "nssm set %s AppDirectory %q\n", serviceName, cfg.WorkingDir)
+	}
+	fmt.Fprintf(&b, "nssm set %s Description %q\n", serviceName, description(cfg, opts))
+	for key, value := range cfg.Environment {
+		fmt.Fprintf(&b, "nssm set %s AppEnvironmentExtra %s=%s\n", serviceName, key, value)
+	}
+	if opts.Restart {
+		fmt.Fprintf(&b, "nssm set %s AppExit Default Restart\n", serviceName)
+	}
+	fmt.Fprintf(&b, "nssm start %s\n", serviceName)
+
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package impl
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ai-launcher/internal/domain/entities"
+)
+
+// detectMemoryBytes returns the host's total physical RAM in bytes, or 0 if
+// it can't be determined on this platform.
+func detectMemoryBytes() uint64 {
+	switch runtime.GOOS {
+	case "linux":
+		return detectMemoryLinux()
+	case "darwin":
+		return detectMemoryDarwin()
+	case "windows":
+		return detectMemoryWindows()
+	default:
+		return 0
+	}
+}
+
+// detectMemoryLinux parses MemTotal out of /proc/meminfo.
+func detectMemoryLinux() uint64 {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return kb * 1024
+		}
+	}
+	return 0
+}
+
+// detectMemoryDarwin shells out to sysctl, the standard way to query total
+// physical memory on macOS.
+func detectMemoryDarwin() uint64 {
+	out, err := exec.Command("sysctl", "-n", "hw.memsize").Output()
+	if err != nil {
+		return 0
+	}
+	bytes, err := strconv.ParseUint(strings.TrimSpace(string(out)), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return bytes
+}
+
+// detectMemoryWindows shells out to wmic, avoiding a direct
+// GlobalMemoryStatusEx syscall binding since this package otherwise has no
+// cgo/syscall Windows dependencies.
+func detectMemoryWindows() uint64 {
+	out, err := exec.Command("wmic", "computersystem", "get", "TotalPhysicalMemory", "/value").Output()
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if value, ok := strings.CutPrefix(line, "TotalPhysicalMemory="); ok {
+			bytes, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return 0
+			}
+			return bytes
+		}
+	}
+	return 0
+}
+
+// detectDiskSpaceBytes returns the free disk space in bytes for the
+// filesystem containing path, or 0 if it can't be determined.
+func detectDiskSpaceBytes(path string) uint64 {
+	if runtime.GOOS == "windows" {
+		return detectDiskSpaceWindows(path)
+	}
+	return detectDiskSpaceUnix(path)
+}
+
+// detectDiskSpaceUnix uses syscall.Statfs, available on Linux and macOS.
+func detectDiskSpaceUnix(path string) uint64 {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0
+	}
+	return stat.Bavail * uint64(stat.Bsize)
+}
+
+// detectDiskSpaceWindows shells out to wmic for the free space of the drive
+// containing path, avoiding a direct GetDiskFreeSpaceExW syscall binding.
+func detectDiskSpaceWindows(path string) uint64 {
+	drive := path
+	if len(path) >= 2 && path[1] == ':' {
+		drive = path[:2]
+	}
+	out, err := exec.Command("wmic", "logicaldisk", "where", fmt.Sprintf("DeviceID='%s'", drive), "get", "FreeSpace", "/value").Output()
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if value, ok := strings.CutPrefix(line, "FreeSpace="); ok {
+			bytes, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return 0
+			}
+			return bytes
+		}
+	}
+	return 0
+}
+
+// detectGPUs returns a short description per GPU found via the platform's
+// vendor tooling (nvidia-smi, rocm-smi, or system_profiler on macOS). An
+// empty slice means no supported GPU tooling was found, not that there's no
+// GPU.
+func detectGPUs() []string {
+	if names := detectGPUsNvidia(); len(names) > 0 {
+		return names
+	}
+	if names := detectGPUsROCm(); len(names) > 0 {
+		return names
+	}
+	if runtime.GOOS == "darwin" {
+		return detectGPUsMacOS()
+	}
+	return nil
+}
+
+func detectGPUsNvidia() []string {
+	out, err := exec.Command("nvidia-smi", "--query-gpu=name", "--format=csv,noheader").Output()
+	if err != nil {
+		return nil
+	}
+	return splitNonEmptyLines(string(out))
+}
+
+func detectGPUsROCm() []string {
+	out, err := exec.Command("rocm-smi", "--showproductname").Output()
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, line := range splitNonEmptyLines(string(out)) {
+		if strings.Contains(line, "Card series") || strings.Contains(line, "Card model") {
+			names = append(names, strings.TrimSpace(line))
+		}
+	}
+	return names
+}
+
+func detectGPUsMacOS() []string {
+	out, err := exec.Command("system_profiler", "SPDisplaysDataType").Output()
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, line := range splitNonEmptyLines(string(out)) {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasSuffix(trimmed, ":") && !strings.Contains(trimmed, "Displays") && !strings.Contains(trimmed, "Graphics/Displays") {
+			names = append(names, strings.TrimSuffix(trimmed, ":"))
+		}
+	}
+	return names
+}
+
+func splitNonEmptyLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			lines = append(lines, trimmed)
+		}
+	}
+	return lines
+}
+
+// toolDependency describes the external tooling a ToolType needs to run
+// and what to suggest when it's missing.
+type toolDependency struct {
+	executable     string
+	recommendation string
+}
+
+// toolDependencies maps each ToolType to the executables CheckDependencies
+// looks for on PATH.
+var toolDependencies = map[entities.ToolType][]toolDependency{
+	entities.ComfyUI:         {{"python3", "Install Python 3.10+ from https://python.org"}},
+	entities.Automatic1111:   {{"python3", "Install Python 3.10+ from https://python.org"}, {"git", "Install git from https://git-scm.com"}},
+	entities.TextGenWebUI:    {{"python3", "Install Python 3.10+ from https://python.org"}, {"git", "Install git from https://git-scm.com"}},
+	entities.StableDiffusion: {{"python3", "Install Python 3.10+ from https://python.org"}},
+	entities.LocalAI:         {{"docker", "Install Docker from https://docker.com, or run the localai binary directly"}},
+}
+
+// checkExecutableDeps reports which of deps' executables are on PATH.
+func checkExecutableDeps(deps []toolDependency) (satisfied, missing, recommendations []string) {
+	for _, dep := range deps {
+		if _, err := exec.LookPath(dep.executable); err == nil {
+			satisfied = append(satisfied, dep.executable)
+		} else {
+			missing = append(missing, dep.executable)
+			recommendations = append(recommendations, dep.recommendation)
+		}
+	}
+	return satisfied, missing, recommendations
+}
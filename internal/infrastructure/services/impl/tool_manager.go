@@ -1,14 +1,19 @@
 package impl
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/ai-launcher/internal/domain/entities"
@@ -16,16 +21,143 @@ import (
 	"github.com/google/uuid"
 )
 
+// stopGracePeriod is how long Stop waits for SIGTERM to take effect before
+// escalating to SIGKILL, mirroring container runtimes' default stop timeout.
+const stopGracePeriod = 10 * time.Second
+
+// healthHistorySize bounds how many past probe results GetHealth reports.
+const healthHistorySize = 10
+
+// logRingBufferSize bounds how many recent output lines are kept in memory
+// per instance for GetLogs/StreamLogs.
+const logRingBufferSize = 10000
+
+// checkpointLogTailSize bounds how many recent output lines a
+// CheckpointData snapshot captures for diagnostics.
+const checkpointLogTailSize = 50
+
+// trackedProcess pairs a tracked instance with the *exec.Cmd that launched
+// it, so Stop/Restart can signal the real OS process and monitorProcess can
+// update the instance when it exits.
+type trackedProcess struct {
+	instance *entities.AIToolInstance
+	cmd      *exec.Cmd
+
+	healthMu     sync.Mutex
+	health       []entities.HealthResult
+	cancelHealth context.CancelFunc
+
+	stoppedManually bool
+	pendingRestart  *time.Timer
+
+	logMu       sync.Mutex
+	logLines    []entities.LogLine
+	subscribers map[chan entities.LogLine]struct{}
+
+	checkpointSeq int // monotonic, incremented by Checkpoint
+}
+
+// appendLog records line in tracked's ring buffer, trimming the oldest
+// entries once logRingBufferSize is exceeded, and fans it out to any active
+// StreamLogs subscribers.
+func (tracked *trackedProcess) appendLog(line entities.LogLine) {
+	tracked.logMu.Lock()
+	tracked.logLines = append(tracked.logLines, line)
+	if len(tracked.logLines) > logRingBufferSize {
+		tracked.logLines = tracked.logLines[len(tracked.logLines)-logRingBufferSize:]
+	}
+	for ch := range tracked.subscribers {
+		select {
+		case ch <- line:
+		default:
+			// slow subscriber; drop the line rather than block the reader goroutine
+		}
+	}
+	tracked.logMu.Unlock()
+}
+
+// subscribe registers ch to receive future log lines until unsubscribe is called.
+func (tracked *trackedProcess) subscribe(ch chan entities.LogLine) {
+	tracked.logMu.Lock()
+	if tracked.subscribers == nil {
+		tracked.subscribers = make(map[chan entities.LogLine]struct{})
+	}
+	tracked.subscribers[ch] = struct{}{}
+	tracked.logMu.Unlock()
+}
+
+// unsubscribe removes ch from tracked's subscriber set.
+func (tracked *trackedProcess) unsubscribe(ch chan entities.LogLine) {
+	tracked.logMu.Lock()
+	delete(tracked.subscribers, ch)
+	tracked.logMu.Unlock()
+}
+
 // ToolManager implements the ToolManager interface
 type ToolManager struct {
+	log        services.Logger
+	eventBus   services.InstanceEventBus
+	adapters   services.AdapterRegistry
 	logService services.LogService
+
+	mu        sync.RWMutex
+	instances map[string]*trackedProcess
 }
 
-// NewToolManager creates a new tool manager
-func NewToolManager(logService services.LogService) *ToolManager {
+// NewToolManager creates a new tool manager. log is typically
+// logService.Logger().Named("toolmanager"). eventBus may be nil, in which
+// case instance lifecycle events are simply not published. adapters may
+// also be nil, in which case ToolManager falls back to generic handling
+// for every tool type instead of delegating to a registered
+// services.ToolAdapter. logService may also be nil, in which case launched
+// instances' stdout/stderr are still captured in their ring buffer but
+// aren't additionally persisted as structured log entries.
+func NewToolManager(log services.Logger, eventBus services.InstanceEventBus, adapters services.AdapterRegistry, logService services.LogService) *ToolManager {
 	return &ToolManager{
+		log:        log,
+		eventBus:   eventBus,
+		adapters:   adapters,
 		logService: logService,
+		instances:  make(map[string]*trackedProcess),
+	}
+}
+
+// adapterFor returns the adapter registered for toolType, if tm has a
+// registry and one is registered.
+func (tm *ToolManager) adapterFor(toolType entities.ToolType) (services.ToolAdapter, bool) {
+	if tm.adapters == nil {
+		return nil, false
+	}
+	return tm.adapters.Get(toolType)
+}
+
+// publish forwards event to tm.eventBus, stamping its timestamp, if an event
+// bus is configured.
+func (tm *ToolManager) publish(instanceID string, toolType entities.ToolType, kind services.InstanceEventKind, status entities.InstanceStatus, message string) {
+	if tm.eventBus == nil {
+		return
+	}
+	tm.eventBus.Publish(services.InstanceEvent{
+		Kind:       kind,
+		InstanceID: instanceID,
+		ToolType:   toolType,
+		Status:     status,
+		Timestamp:  time.Now(),
+		Message:    message,
+	})
+}
+
+// buildCommand constructs the *exec.Cmd that launches config, delegating
+// to config.Type's registered adapter when there is one and falling back
+// to generic argument handling otherwise.
+func (tm *ToolManager) buildCommand(ctx context.Context, config entities.Configuration) (*exec.Cmd, error) {
+	if adapter, ok := tm.adapterFor(config.Type); ok {
+		return adapter.BuildCommand(ctx, config)
 	}
+
+	cmd := exec.CommandContext(ctx, config.ExecutablePath, config.Arguments...)
+	cmd.Dir = config.WorkingDir
+	return cmd, nil
 }
 
 // Launch starts a new instance of an AI tool
@@ -35,22 +167,26 @@ func (tm *ToolManager) Launch(ctx context.Context, config entities.Configuration
 		return nil, fmt.Errorf("executable not found: %s", config.ExecutablePath)
 	}
 
-	// Prepare command arguments
-	args := config.Arguments
-	if len(args) == 0 {
-		args = tm.getDefaultArgs(config.Type, config.Port, config.Host)
+	cmd, err := tm.buildCommand(ctx, config)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create command
-	cmd := exec.CommandContext(ctx, config.ExecutablePath, args...)
-	cmd.Dir = config.WorkingDir
-	
 	// Set environment variables
 	cmd.Env = os.Environ()
 	for key, value := range config.Environment {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
 	}
 
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach stdout: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach stderr: %w", err)
+	}
+
 	// Start the process
 	if err := cmd.Start(); err != nil {
 		return nil, fmt.Errorf("failed to start process: %w", err)
@@ -67,117 +203,638 @@ func (tm *ToolManager) Launch(ctx context.Context, config entities.Configuration
 		StartedAt: &now,
 	}
 
+	tracked := &trackedProcess{instance: instance, cmd: cmd}
+	tm.publish(instance.ID, config.Type, services.InstanceLaunching, entities.StatusStarting, "")
+
+	tm.mu.Lock()
+	tm.instances[instance.ID] = tracked
+	tm.mu.Unlock()
+
 	// Monitor the process in a goroutine
-	go tm.monitorProcess(instance, cmd)
+	go tm.monitorProcess(tracked)
+
+	stdoutForRingBuffer, stderrForRingBuffer := stdout, stderr
+
+	if tm.logService != nil {
+		if config.LogLevel != "" {
+			tm.logService.SetInstanceLevel(instance.ID, parseLevel(config.LogLevel))
+		}
+
+		var stdoutForLogService, stderrForLogService io.Reader
+		stdoutForRingBuffer, stdoutForLogService = teeReader(stdout)
+		stderrForRingBuffer, stderrForLogService = teeReader(stderr)
+		go tm.logService.StreamProcessOutput(instance.ID, stdoutForLogService, stderrForLogService)
+	}
+
+	go tm.streamOutput(tracked, entities.LogStreamStdout, stdoutForRingBuffer)
+	go tm.streamOutput(tracked, entities.LogStreamStderr, stderrForRingBuffer)
+
+	if hc := config.HealthCheck; hc != nil {
+		healthCtx, cancel := context.WithCancel(context.Background())
+		tracked.cancelHealth = cancel
+		go tm.runHealthChecks(healthCtx, tracked, *hc)
+	}
 
 	return instance, nil
 }
 
-// Stop terminates a running instance
+// Stop terminates a running instance, sending SIGTERM and escalating to
+// SIGKILL if the process hasn't exited within stopGracePeriod.
 func (tm *ToolManager) Stop(ctx context.Context, instanceID string) error {
-	// For now, we'll implement a simple stop mechanism
-	// In a real implementation, this would track running processes
-	tm.logService.WriteLog(instanceID, services.LogLevelInfo, "Stop requested")
-	return nil
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return err
+	}
+
+	if tracked.cancelHealth != nil {
+		tracked.cancelHealth()
+	}
+
+	tm.mu.Lock()
+	tracked.stoppedManually = true
+	if tracked.pendingRestart != nil {
+		tracked.pendingRestart.Stop()
+	}
+	tm.mu.Unlock()
+
+	tm.setStatus(tracked.instance, entities.StatusStopping)
+	tm.log.Info("stop requested", "instance_id", instanceID)
+
+	if err := tracked.instance.Process.Signal(syscall.SIGTERM); err != nil && err != os.ErrProcessDone {
+		return fmt.Errorf("failed to signal process: %w", err)
+	}
+
+	deadline := time.After(stopGracePeriod)
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-deadline:
+			tm.log.Warn("process did not stop gracefully, sending SIGKILL", "instance_id", instanceID)
+			if err := tracked.instance.Process.Kill(); err != nil && err != os.ErrProcessDone {
+				return fmt.Errorf("failed to kill process: %w", err)
+			}
+			return nil
+		case <-ticker.C:
+			if !processAlive(tracked.instance.Process) {
+				return nil
+			}
+		}
+	}
 }
 
-// Restart stops and starts an instance
+// Restart stops an instance and relaunches it with its stored configuration,
+// reusing the same instance ID.
 func (tm *ToolManager) Restart(ctx context.Context, instanceID string) error {
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return err
+	}
+	config := tracked.instance.Config
+
 	if err := tm.Stop(ctx, instanceID); err != nil {
 		return err
 	}
-	
-	// Wait a moment for the process to stop
-	time.Sleep(2 * time.Second)
-	
-	// For now, return success - in a real implementation we'd relaunch
-	tm.logService.WriteLog(instanceID, services.LogLevelInfo, "Restart completed")
+
+	relaunched, err := tm.Launch(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to relaunch instance: %w", err)
+	}
+
+	tm.mu.Lock()
+	entry := tm.instances[relaunched.ID]
+	delete(tm.instances, relaunched.ID)
+	relaunched.ID = instanceID
+	tm.instances[instanceID] = entry
+	tm.mu.Unlock()
+
+	tm.log.Info("restart completed", "instance_id", instanceID)
 	return nil
 }
 
-// GetStatus returns the current status of an instance
+// GetStatus returns the current status of an instance, cross-checking the
+// tracked OS process' liveness rather than trusting the last recorded state.
 func (tm *ToolManager) GetStatus(ctx context.Context, instanceID string) (entities.InstanceStatus, error) {
-	// For now, return running - in a real implementation we'd check the actual process
-	return entities.StatusRunning, nil
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return "", err
+	}
+
+	tm.mu.RLock()
+	status := tracked.instance.Status
+	attempts := tracked.instance.RestartAttempts
+	maxRetries := tracked.instance.Config.RestartPolicy.MaximumRetries
+	nextRestartAt := tracked.instance.NextRestartAt
+	port := tracked.instance.Config.Port
+	pid := tracked.instance.PID
+	tm.mu.RUnlock()
+
+	if status == entities.StatusRunning || status == entities.StatusStarting {
+		if !processAlive(tracked.instance.Process) {
+			return entities.StatusError, nil
+		}
+		if owner, err := portOwner(port); err == nil && owner != nil && owner.PID != pid {
+			return entities.StatusError, nil
+		}
+	}
+
+	if status == entities.StatusRestarting && nextRestartAt != nil {
+		retries := "unlimited"
+		if maxRetries > 0 {
+			retries = strconv.Itoa(maxRetries)
+		}
+		return entities.InstanceStatus(fmt.Sprintf("restarting (attempt %d/%s, next in %ds)",
+			attempts, retries, int(time.Until(*nextRestartAt).Seconds()))), nil
+	}
+
+	return status, nil
 }
 
-// GetDefaultConfig returns the default configuration for a tool type
-func (tm *ToolManager) GetDefaultConfig(toolType entities.ToolType) entities.Configuration {
-	config := entities.Configuration{
+// List returns a point-in-time snapshot of every tracked instance, safe to
+// render from a UI/API without racing against monitorProcess updates.
+func (tm *ToolManager) List(ctx context.Context) []*entities.AIToolInstance {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	snapshots := make([]*entities.AIToolInstance, 0, len(tm.instances))
+	for _, tracked := range tm.instances {
+		snapshot := *tracked.instance
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	return snapshots
+}
+
+// GetHealth returns an instance's current health status and recent probe
+// history, most recent last.
+func (tm *ToolManager) GetHealth(ctx context.Context, instanceID string) (entities.HealthState, error) {
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return entities.HealthState{}, err
+	}
+
+	tm.mu.RLock()
+	status := tracked.instance.Status
+	tm.mu.RUnlock()
+
+	tracked.healthMu.Lock()
+	history := make([]entities.HealthResult, len(tracked.health))
+	copy(history, tracked.health)
+	tracked.healthMu.Unlock()
+
+	return entities.HealthState{Status: status, History: history}, nil
+}
+
+// Checkpoint captures a point-in-time snapshot of instanceID's
+// configuration, process state, and recent output, plus a tool-specific
+// resume token produced by its adapter (if one is registered), for later
+// replay by Restore.
+func (tm *ToolManager) Checkpoint(ctx context.Context, instanceID string) (entities.CheckpointData, error) {
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return entities.CheckpointData{}, err
+	}
+
+	tm.mu.Lock()
+	tracked.checkpointSeq++
+	sequence := tracked.checkpointSeq
+	tm.mu.Unlock()
+
+	tm.mu.RLock()
+	pid := tracked.instance.PID
+	config := tracked.instance.Config
+	tm.mu.RUnlock()
+
+	tracked.logMu.Lock()
+	logTail := make([]entities.LogLine, len(tracked.logLines))
+	copy(logTail, tracked.logLines)
+	tracked.logMu.Unlock()
+	if len(logTail) > checkpointLogTailSize {
+		logTail = logTail[len(logTail)-checkpointLogTailSize:]
+	}
+
+	var resumeToken string
+	if adapter, ok := tm.adapterFor(config.Type); ok {
+		resumeToken, err = adapter.Checkpoint(ctx, tracked.instance)
+		if err != nil {
+			return entities.CheckpointData{}, fmt.Errorf("failed to capture %s resume token: %w", config.Type, err)
+		}
+	}
+
+	environment := make(map[string]string, len(config.Environment))
+	for key, value := range config.Environment {
+		environment[key] = value
+	}
+
+	return entities.CheckpointData{
 		ID:          uuid.New().String(),
-		Type:        toolType,
-		Host:        "127.0.0.1",
-		AutoStart:   false,
-		Environment: make(map[string]string),
+		InstanceID:  instanceID,
+		Config:      config,
+		LastPID:     pid,
+		Environment: environment,
+		LogTail:     logTail,
+		Sequence:    sequence,
+		ResumeToken: resumeToken,
 		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+// Restore relaunches checkpoint.Config and, if checkpoint.ResumeToken is
+// set, replays it against the new instance via its adapter's Restore
+// method (e.g. re-pulling a previously loaded Ollama model). The relaunch
+// is a fresh process; Restore does not reuse the checkpointed instance ID.
+func (tm *ToolManager) Restore(ctx context.Context, checkpoint entities.CheckpointData) (*entities.AIToolInstance, error) {
+	instance, err := tm.Launch(ctx, checkpoint.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to relaunch checkpointed instance: %w", err)
 	}
 
-	switch toolType {
-	case entities.ComfyUI:
-		config.Name = "ComfyUI Instance"
-		config.Port = 8188
-		config.Arguments = []string{"--listen", "127.0.0.1", "--port", "8188"}
-	case entities.Automatic1111:
-		config.Name = "Automatic1111 Instance"
-		config.Port = 7860
-		config.Arguments = []string{"--listen", "--port", "7860"}
-	case entities.Ollama:
-		config.Name = "Ollama Instance"
-		config.Port = 11434
-		config.Arguments = []string{"serve"}
-	case entities.LMStudio:
-		config.Name = "LM Studio Instance"
-		config.Port = 1234
-		config.Arguments = []string{}
-	case entities.TextGenWebUI:
-		config.Name = "Text Generation WebUI Instance"
-		config.Port = 7860
-		config.Arguments = []string{"--listen", "--listen-port", "7860"}
+	if checkpoint.ResumeToken == "" {
+		return instance, nil
+	}
+
+	adapter, ok := tm.adapterFor(checkpoint.Config.Type)
+	if !ok {
+		return instance, nil
+	}
+
+	if err := adapter.Restore(ctx, instance, checkpoint.ResumeToken); err != nil {
+		return instance, fmt.Errorf("failed to replay resume token: %w", err)
+	}
+	return instance, nil
+}
+
+// tracked looks up the registry entry for instanceID.
+func (tm *ToolManager) tracked(instanceID string) (*trackedProcess, error) {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	tracked, ok := tm.instances[instanceID]
+	if !ok {
+		return nil, fmt.Errorf("instance not found: %s", instanceID)
+	}
+	return tracked, nil
+}
+
+// setStatus updates an instance's status under the registry lock.
+func (tm *ToolManager) setStatus(instance *entities.AIToolInstance, status entities.InstanceStatus) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+	instance.Status = status
+}
+
+// processAlive reports whether process still responds to a null signal,
+// the standard liveness check since os.Process has no IsRunning method.
+func processAlive(process *os.Process) bool {
+	if process == nil {
+		return false
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// runHealthChecks probes tracked's instance on a ticker until ctx is
+// cancelled, recording each result and transitioning the instance status
+// between Starting, Healthy, and Unhealthy.
+func (tm *ToolManager) runHealthChecks(ctx context.Context, tracked *trackedProcess, hc entities.HealthCheck) {
+	startedAt := time.Now()
+	consecutiveFailures := 0
+
+	ticker := time.NewTicker(hc.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result := tm.healthProbe(ctx, tracked.instance, hc)
+
+		tracked.healthMu.Lock()
+		tracked.health = append(tracked.health, result)
+		if len(tracked.health) > healthHistorySize {
+			tracked.health = tracked.health[len(tracked.health)-healthHistorySize:]
+		}
+		tracked.healthMu.Unlock()
+
+		inStartPeriod := time.Since(startedAt) < hc.StartPeriod
+
+		if result.Success {
+			wasHealthy := consecutiveFailures == 0 && tracked.instance.Status == entities.StatusHealthy
+			consecutiveFailures = 0
+			tm.setStatus(tracked.instance, entities.StatusHealthy)
+			if !wasHealthy {
+				tm.publish(tracked.instance.ID, tracked.instance.Config.Type, services.InstanceRunning, entities.StatusHealthy, "")
+			}
+			continue
+		}
+
+		if inStartPeriod {
+			continue
+		}
+
+		consecutiveFailures++
+		tm.log.Warn("healthcheck failed", "instance_id", tracked.instance.ID,
+			"attempt", consecutiveFailures, "retries", hc.Retries, "error", result.Error)
+
+		if consecutiveFailures >= hc.Retries {
+			tm.setStatus(tracked.instance, entities.StatusUnhealthy)
+			tm.publish(tracked.instance.ID, tracked.instance.Config.Type, services.InstanceCrashed, entities.StatusUnhealthy, result.Error)
+		} else {
+			tm.publish(tracked.instance.ID, tracked.instance.Config.Type, services.InstanceHealthDegraded, entities.StatusHealthy, result.Error)
+		}
+	}
+}
+
+// healthProbe runs a single healthcheck attempt against instance,
+// delegating to its adapter's HealthCheck when one is registered for its
+// tool type and falling back to the generic probe otherwise.
+func (tm *ToolManager) healthProbe(ctx context.Context, instance *entities.AIToolInstance, hc entities.HealthCheck) entities.HealthResult {
+	adapter, ok := tm.adapterFor(instance.Config.Type)
+	if !ok {
+		return probe(ctx, instance.Config, hc)
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, hc.Timeout)
+	defer cancel()
+
+	result := entities.HealthResult{Timestamp: time.Now()}
+	if err := adapter.HealthCheck(probeCtx, instance); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Success = true
+	return result
+}
+
+// probe runs a single healthcheck attempt against config per hc's type.
+func probe(ctx context.Context, config entities.Configuration, hc entities.HealthCheck) entities.HealthResult {
+	result := entities.HealthResult{Timestamp: time.Now()}
+
+	probeCtx, cancel := context.WithTimeout(ctx, hc.Timeout)
+	defer cancel()
+
+	var err error
+	switch hc.Type {
+	case entities.HealthCheckHTTP:
+		err = probeHTTP(probeCtx, config, hc)
+	case entities.HealthCheckTCP:
+		err = probeTCP(probeCtx, config)
+	case entities.HealthCheckExec:
+		err = probeExec(probeCtx, hc)
 	default:
-		config.Name = "AI Tool Instance"
-		config.Port = 8080
-		config.Arguments = []string{}
+		err = fmt.Errorf("unsupported healthcheck type: %s", hc.Type)
+	}
+
+	if err != nil {
+		result.Success = false
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Success = true
+	return result
+}
+
+func probeHTTP(ctx context.Context, config entities.Configuration, hc entities.HealthCheck) error {
+	url := fmt.Sprintf("http://%s:%d%s", config.Host, config.Port, hc.Path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("unhealthy status code: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func probeTCP(ctx context.Context, config entities.Configuration) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", config.Host, config.Port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func probeExec(ctx context.Context, hc entities.HealthCheck) error {
+	if len(hc.Command) == 0 {
+		return fmt.Errorf("exec healthcheck has no command configured")
 	}
 
-	return config
+	cmd := exec.CommandContext(ctx, hc.Command[0], hc.Command[1:]...)
+	return cmd.Run()
 }
 
-// ValidateConfig validates a configuration before launching
+// GetDefaultConfig returns the default configuration for a tool type,
+// delegating to its registered adapter when there is one and falling back
+// to a generic starting point otherwise.
+func (tm *ToolManager) GetDefaultConfig(toolType entities.ToolType) entities.Configuration {
+	if adapter, ok := tm.adapterFor(toolType); ok {
+		return adapter.DefaultConfig()
+	}
+
+	now := time.Now()
+	return entities.Configuration{
+		ID:          uuid.New().String(),
+		Type:        toolType,
+		Name:        "AI Tool Instance",
+		Host:        "127.0.0.1",
+		Port:        8080,
+		Arguments:   []string{},
+		AutoStart:   false,
+		Environment: make(map[string]string),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// ValidateConfig validates a configuration before launching, then runs its
+// adapter's own validation, if one is registered for config.Type.
 func (tm *ToolManager) ValidateConfig(config entities.Configuration) error {
 	if config.ExecutablePath == "" {
 		return fmt.Errorf("executable path is required")
 	}
-	
+
 	if config.Port <= 0 || config.Port > 65535 {
 		return fmt.Errorf("port must be between 1 and 65535")
 	}
-	
+
 	if config.Host == "" {
 		return fmt.Errorf("host is required")
 	}
-	
+
+	if adapter, ok := tm.adapterFor(config.Type); ok {
+		return adapter.Validate(config)
+	}
+
 	return nil
 }
 
-// GetLogs retrieves logs for an instance
+// GetLogs retrieves the most recent lines captured from an instance's
+// stdout/stderr, formatted as "[stream] line".
 func (tm *ToolManager) GetLogs(ctx context.Context, instanceID string, lines int) ([]string, error) {
-	// For now, return mock logs
-	logs := []string{
-		fmt.Sprintf("[%s] Instance %s started", time.Now().Format(time.RFC3339), instanceID),
-		fmt.Sprintf("[%s] Listening on port", time.Now().Format(time.RFC3339)),
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tracked.logMu.Lock()
+	buffered := make([]entities.LogLine, len(tracked.logLines))
+	copy(buffered, tracked.logLines)
+	tracked.logMu.Unlock()
+
+	if lines > 0 && len(buffered) > lines {
+		buffered = buffered[len(buffered)-lines:]
+	}
+
+	logs := make([]string, 0, len(buffered))
+	for _, l := range buffered {
+		logs = append(logs, fmt.Sprintf("[%s] %s", l.Stream, l.Line))
 	}
 	return logs, nil
 }
 
-// IsPortAvailable checks if a port is available for use
+// StreamLogs returns a channel of an instance's captured log lines. The
+// channel is pre-seeded with the buffered lines within opts.Since/opts.Until,
+// only the last opts.Tail of them when Tail is set; with opts.Follow set, the
+// channel stays open and receives new lines as they arrive until ctx is
+// cancelled, including after the instance's process has exited.
+func (tm *ToolManager) StreamLogs(ctx context.Context, instanceID string, opts entities.LogStreamOptions) (<-chan entities.LogLine, error) {
+	tracked, err := tm.tracked(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan entities.LogLine, logRingBufferSize)
+
+	tracked.logMu.Lock()
+	buffered := make([]entities.LogLine, len(tracked.logLines))
+	copy(buffered, tracked.logLines)
+	if opts.Follow {
+		// registered inline because subscribe takes logMu itself
+		if tracked.subscribers == nil {
+			tracked.subscribers = make(map[chan entities.LogLine]struct{})
+		}
+		tracked.subscribers[out] = struct{}{}
+	}
+	tracked.logMu.Unlock()
+
+	buffered = filterLogLines(buffered, opts)
+
+	go func() {
+		defer func() {
+			if opts.Follow {
+				tracked.unsubscribe(out)
+			}
+			close(out)
+		}()
+
+		for _, line := range buffered {
+			select {
+			case out <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if !opts.Follow {
+			return
+		}
+
+		<-ctx.Done()
+	}()
+
+	return out, nil
+}
+
+// filterLogLines applies opts.Tail/Since/Until to a slice of buffered lines.
+func filterLogLines(lines []entities.LogLine, opts entities.LogStreamOptions) []entities.LogLine {
+	filtered := lines[:0:0]
+	for _, l := range lines {
+		if !opts.Since.IsZero() && l.Timestamp.Before(opts.Since) {
+			continue
+		}
+		if !opts.Until.IsZero() && l.Timestamp.After(opts.Until) {
+			continue
+		}
+		filtered = append(filtered, l)
+	}
+	if opts.Tail > 0 && len(filtered) > opts.Tail {
+		filtered = filtered[len(filtered)-opts.Tail:]
+	}
+	return filtered
+}
+
+// teeReader splits r into two independent readers that each see every byte
+// r produces, so two separate line-scanning consumers (streamOutput's ring
+// buffer and LogService's structured capture) can each read the same
+// process output without racing over a single io.Reader.
+func teeReader(r io.Reader) (a, b io.Reader) {
+	pr1, pw1 := io.Pipe()
+	pr2, pw2 := io.Pipe()
+	go func() {
+		_, err := io.Copy(io.MultiWriter(pw1, pw2), r)
+		pw1.CloseWithError(err)
+		pw2.CloseWithError(err)
+	}()
+	return pr1, pr2
+}
+
+// streamOutput scans r line-by-line, appending each line to tracked's ring
+// buffer and publishing it over the event bus, until r is closed (normally
+// because the process exited).
+func (tm *ToolManager) streamOutput(tracked *trackedProcess, stream entities.LogStream, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := entities.LogLine{
+			Timestamp: time.Now(),
+			Stream:    stream,
+			Line:      scanner.Text(),
+		}
+		tracked.appendLog(line)
+
+		if tm.eventBus != nil {
+			tm.eventBus.Publish(services.InstanceEvent{
+				Kind:       services.InstanceLog,
+				InstanceID: tracked.instance.ID,
+				ToolType:   tracked.instance.Config.Type,
+				Timestamp:  line.Timestamp,
+				Log:        &line,
+			})
+		}
+	}
+}
+
+// IsPortAvailable checks if a port is available for use by asking the host
+// who currently owns it, rather than racing a bind-probe against whatever
+// else might grab the port between the probe and the real listener
+// starting.
 func (tm *ToolManager) IsPortAvailable(port int) bool {
-	conn, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	owner, err := portOwner(port)
 	if err != nil {
-		return false
+		// fall back to a bind-probe if the connection table couldn't be inspected
+		conn, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
 	}
-	conn.Close()
-	return true
+	return owner == nil
 }
 
 // FindAvailablePort finds an available port in a given range
@@ -190,40 +847,206 @@ func (tm *ToolManager) FindAvailablePort(startPort, endPort int) (int, error) {
 	return 0, fmt.Errorf("no available port found in range %d-%d", startPort, endPort)
 }
 
-// getDefaultArgs returns default arguments for a tool type
-func (tm *ToolManager) getDefaultArgs(toolType entities.ToolType, port int, host string) []string {
-	portStr := strconv.Itoa(port)
-	
-	switch toolType {
-	case entities.ComfyUI:
-		return []string{"--listen", host, "--port", portStr}
-	case entities.Automatic1111:
-		return []string{"--listen", "--port", portStr}
-	case entities.Ollama:
-		return []string{"serve"}
-	case entities.TextGenWebUI:
-		return []string{"--listen", "--listen-port", portStr}
-	default:
-		return []string{}
+// ReconcilePorts cross-checks every tracked instance's configured port
+// against the host's actual connection table, returning a PortConflict for
+// each instance whose port is unbound or owned by an unexpected PID.
+func (tm *ToolManager) ReconcilePorts(ctx context.Context) ([]entities.PortConflict, error) {
+	tm.mu.RLock()
+	tracked := make([]*trackedProcess, 0, len(tm.instances))
+	for _, t := range tm.instances {
+		tracked = append(tracked, t)
+	}
+	tm.mu.RUnlock()
+
+	var conflicts []entities.PortConflict
+	for _, t := range tracked {
+		if t.instance.Status != entities.StatusRunning && t.instance.Status != entities.StatusHealthy {
+			continue
+		}
+
+		owner, err := portOwner(t.instance.Config.Port)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect port %d: %w", t.instance.Config.Port, err)
+		}
+		if owner != nil && owner.PID == t.instance.PID {
+			continue
+		}
+
+		conflicts = append(conflicts, entities.PortConflict{
+			InstanceID:  t.instance.ID,
+			ExpectedPID: t.instance.PID,
+			ActualOwner: owner,
+		})
 	}
+	return conflicts, nil
 }
 
 // monitorProcess monitors a running process and updates its status
-func (tm *ToolManager) monitorProcess(instance *entities.AIToolInstance, cmd *exec.Cmd) {
+func (tm *ToolManager) monitorProcess(tracked *trackedProcess) {
+	instance, cmd := tracked.instance, tracked.cmd
+
 	// Wait for the process to finish
 	err := cmd.Wait()
-	
+
+	if tracked.cancelHealth != nil {
+		tracked.cancelHealth()
+	}
+
+	tm.mu.Lock()
+
 	if err != nil {
 		instance.Status = entities.StatusError
 		instance.LastError = err.Error()
-		tm.logService.WriteLog(instance.ID, services.LogLevelError, fmt.Sprintf("Process exited with error: %s", err.Error()))
+		tm.log.Error("process exited with error", "instance_id", instance.ID, "error", err.Error())
 	} else {
 		instance.Status = entities.StatusStopped
-		tm.logService.WriteLog(instance.ID, services.LogLevelInfo, "Process exited normally")
+		tm.log.Info("process exited normally", "instance_id", instance.ID)
+	}
+
+	if cmd.ProcessState != nil {
+		exitCode := cmd.ProcessState.ExitCode()
+		instance.ExitCode = &exitCode
 	}
-	
+
 	now := time.Now()
 	instance.StoppedAt = &now
+	stoppedManually := tracked.stoppedManually
+	status := instance.Status
+
+	tm.mu.Unlock()
+
+	if status == entities.StatusError {
+		tm.publish(instance.ID, instance.Config.Type, services.InstanceCrashed, status, instance.LastError)
+	} else {
+		tm.publish(instance.ID, instance.Config.Type, services.InstanceStopped, status, "")
+	}
+
+	if tm.shouldRestart(tracked, err, stoppedManually) {
+		tm.scheduleRestart(tracked)
+	}
+}
+
+// backoffForAttempt computes how long to wait before the given restart
+// attempt (1-indexed), doubling policy.MinimumBackoff per attempt and
+// capping at policy.MaximumBackoff. Zero-valued bounds fall back to sensible
+// defaults so a caller need not set them explicitly.
+func backoffForAttempt(policy entities.RestartPolicy, attempt int) time.Duration {
+	min := policy.MinimumBackoff
+	if min <= 0 {
+		min = time.Second
+	}
+	max := policy.MaximumBackoff
+	if max <= 0 {
+		max = 60 * time.Second
+	}
+
+	backoff := min
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= max {
+			break
+		}
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// shouldRestart reports whether an instance should be relaunched after its
+// process exited, per its configured RestartPolicy.
+func (tm *ToolManager) shouldRestart(tracked *trackedProcess, exitErr error, stoppedManually bool) bool {
+	policy := tracked.instance.Config.RestartPolicy
+
+	switch policy.Mode {
+	case entities.RestartAlways:
+		// restarts even after a manual stop would fight the user, so still honor it
+		if stoppedManually {
+			return false
+		}
+	case entities.RestartUnlessStopped:
+		if stoppedManually {
+			return false
+		}
+	case entities.RestartOnFailure:
+		if stoppedManually || exitErr == nil {
+			return false
+		}
+	default: // entities.RestartNo, or unset
+		return false
+	}
+
+	if policy.MaximumRetries > 0 && tracked.instance.RestartAttempts >= policy.MaximumRetries {
+		return false
+	}
+	return true
+}
+
+// scheduleRestart records a restart attempt and relaunches the instance
+// after an exponential backoff delay, mirroring container runtimes' crash
+// loop backoff behavior.
+func (tm *ToolManager) scheduleRestart(tracked *trackedProcess) {
+	tm.mu.Lock()
+	tracked.instance.RestartAttempts++
+	attempt := tracked.instance.RestartAttempts
+	backoff := backoffForAttempt(tracked.instance.Config.RestartPolicy, attempt)
+	nextAt := time.Now().Add(backoff)
+	tracked.instance.Status = entities.StatusRestarting
+	tracked.instance.NextRestartAt = &nextAt
+	// Assigned while still holding tm.mu: the callback itself takes tm.mu
+	// before checking stoppedManually, so it can't read a stale
+	// tracked.pendingRestart and Stop can't race past this point without
+	// either observing the timer (and Stop-ping it) or the callback
+	// observing stoppedManually (and declining to relaunch).
+	tracked.pendingRestart = time.AfterFunc(backoff, func() {
+		tm.mu.Lock()
+		stopped := tracked.stoppedManually
+		tm.mu.Unlock()
+		if stopped {
+			return
+		}
+		tm.runScheduledRestart(tracked)
+	})
+	tm.mu.Unlock()
+
+	tm.log.Info("scheduling restart", "instance_id", tracked.instance.ID, "attempt", attempt, "backoff", backoff.String())
+}
+
+// runScheduledRestart relaunches tracked's instance with its stored
+// configuration, preserving the original instance ID, and arms a
+// success-window timer that resets the attempt counter if the relaunch
+// stays up long enough.
+func (tm *ToolManager) runScheduledRestart(tracked *trackedProcess) {
+	instanceID := tracked.instance.ID
+	config := tracked.instance.Config
+
+	relaunched, err := tm.Launch(context.Background(), config)
+	if err != nil {
+		tm.log.Error("scheduled restart failed", "instance_id", instanceID, "error", err.Error())
+		return
+	}
+
+	tm.mu.Lock()
+	entry := tm.instances[relaunched.ID]
+	delete(tm.instances, relaunched.ID)
+	relaunched.ID = instanceID
+	entry.instance.RestartAttempts = tracked.instance.RestartAttempts
+	tm.instances[instanceID] = entry
+	tm.mu.Unlock()
+
+	tm.log.Info("scheduled restart completed", "instance_id", instanceID)
+
+	successWindow := config.RestartPolicy.SuccessWindow
+	if successWindow <= 0 {
+		successWindow = 60 * time.Second
+	}
+	time.AfterFunc(successWindow, func() {
+		tm.mu.Lock()
+		defer tm.mu.Unlock()
+		if entry.instance.Status == entities.StatusRunning || entry.instance.Status == entities.StatusHealthy {
+			entry.instance.RestartAttempts = 0
+		}
+	})
 }
 
 // SystemService implements the SystemService interface
@@ -234,25 +1057,44 @@ func NewSystemService() *SystemService {
 	return &SystemService{}
 }
 
-// GetSystemInfo returns system information
+// GetSystemInfo returns system information, fingerprinting actual memory,
+// disk space, and GPU hardware rather than reporting zeros.
 func (ss *SystemService) GetSystemInfo() services.SystemInfo {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		homeDir = "."
+	}
 	return services.SystemInfo{
 		OS:           runtime.GOOS,
 		Architecture: runtime.GOARCH,
 		CPUCores:     runtime.NumCPU(),
-		Memory:       0, // Would implement actual memory detection
-		DiskSpace:    0, // Would implement actual disk space detection
+		Memory:       detectMemoryBytes(),
+		DiskSpace:    detectDiskSpaceBytes(homeDir),
+		GPUs:         detectGPUs(),
 	}
 }
 
-// CheckDependencies verifies required dependencies are installed
+// CheckDependencies verifies the external tooling a ToolType needs is
+// installed, returning actionable MissingDeps/Recommendations for whatever
+// isn't.
 func (ss *SystemService) CheckDependencies(toolType entities.ToolType) services.DependencyStatus {
-	// For now, return that everything is available
+	deps, ok := toolDependencies[toolType]
+	if !ok {
+		// no known external dependencies (e.g. self-contained binaries like Ollama/LMStudio)
+		return services.DependencyStatus{
+			Available:       true,
+			MissingDeps:     []string{},
+			Satisfied:       []string{"system"},
+			Recommendations: []string{},
+		}
+	}
+
+	satisfied, missing, recommendations := checkExecutableDeps(deps)
 	return services.DependencyStatus{
-		Available:       true,
-		MissingDeps:     []string{},
-		Satisfied:       []string{"system"},
-		Recommendations: []string{},
+		Available:       len(missing) == 0,
+		MissingDeps:     missing,
+		Satisfied:       satisfied,
+		Recommendations: recommendations,
 	}
 }
 
@@ -275,12 +1117,12 @@ func (ss *SystemService) ValidatePath(path string) error {
 	if strings.Contains(path, "..") {
 		return fmt.Errorf("path traversal detected in: %s", path)
 	}
-	
+
 	// Check if path exists
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return fmt.Errorf("path does not exist: %s", path)
 	}
-	
+
 	return nil
 }
 
@@ -0,0 +1,184 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ai-launcher/internal/domain/entities"
+	"github.com/ai-launcher/internal/domain/services"
+	"github.com/ai-launcher/internal/transport/grpc/toolmanagerpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Server exposes a ToolManager over gRPC so external UIs and agents can
+// drive it without linking against this process directly.
+type Server struct {
+	toolmanagerpb.UnimplementedToolManagerServiceServer
+
+	toolManager services.ToolManager
+	log         services.Logger
+}
+
+// NewServer creates a gRPC control-plane server backed by toolManager. log
+// is typically logService.Logger().Named("grpc").
+func NewServer(toolManager services.ToolManager, log services.Logger) *Server {
+	return &Server{toolManager: toolManager, log: log}
+}
+
+// Serve starts a gRPC server on lis. If tlsConfig is non-nil, client
+// certificates are required and verified (mTLS); if nil, the server runs in
+// plaintext, suitable for binding to localhost only.
+func (s *Server) Serve(lis net.Listener, tlsConfig *tls.Config) error {
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	toolmanagerpb.RegisterToolManagerServiceServer(grpcServer, s)
+	return grpcServer.Serve(lis)
+}
+
+func toConfiguration(cfg *toolmanagerpb.Configuration) entities.Configuration {
+	if cfg == nil {
+		return entities.Configuration{}
+	}
+	var policy entities.RestartPolicy
+	if cfg.RestartPolicy != nil {
+		policy = entities.RestartPolicy{
+			Mode:           entities.RestartPolicyMode(cfg.RestartPolicy.Mode),
+			MaximumRetries: int(cfg.RestartPolicy.MaximumRetries),
+			MinimumBackoff: time.Duration(cfg.RestartPolicy.MinimumBackoffMs) * time.Millisecond,
+			MaximumBackoff: time.Duration(cfg.RestartPolicy.MaximumBackoffMs) * time.Millisecond,
+		}
+	}
+	return entities.Configuration{
+		ID:             cfg.Id,
+		Name:           cfg.Name,
+		Type:           entities.ToolType(cfg.Type),
+		ExecutablePath: cfg.ExecutablePath,
+		WorkingDir:     cfg.WorkingDir,
+		Port:           int(cfg.Port),
+		Host:           cfg.Host,
+		Arguments:      cfg.Arguments,
+		Environment:    cfg.Environment,
+		AutoStart:      cfg.AutoStart,
+		RestartPolicy:  policy,
+	}
+}
+
+// Launch launches the requested Configuration via the underlying ToolManager.
+func (s *Server) Launch(ctx context.Context, req *toolmanagerpb.LaunchRequest) (*toolmanagerpb.LaunchResponse, error) {
+	instance, err := s.toolManager.Launch(ctx, toConfiguration(req.Config))
+	if err != nil {
+		return nil, fmt.Errorf("launch failed: %w", err)
+	}
+	return &toolmanagerpb.LaunchResponse{
+		InstanceId: instance.ID,
+		Pid:        int32(instance.PID),
+		Status:     string(instance.Status),
+	}, nil
+}
+
+// Stop stops the requested instance via the underlying ToolManager.
+func (s *Server) Stop(ctx context.Context, req *toolmanagerpb.StopRequest) (*toolmanagerpb.StopResponse, error) {
+	if err := s.toolManager.Stop(ctx, req.InstanceId); err != nil {
+		return nil, fmt.Errorf("stop failed: %w", err)
+	}
+	return &toolmanagerpb.StopResponse{}, nil
+}
+
+// Restart restarts the requested instance via the underlying ToolManager.
+func (s *Server) Restart(ctx context.Context, req *toolmanagerpb.RestartRequest) (*toolmanagerpb.RestartResponse, error) {
+	if err := s.toolManager.Restart(ctx, req.InstanceId); err != nil {
+		return nil, fmt.Errorf("restart failed: %w", err)
+	}
+	return &toolmanagerpb.RestartResponse{}, nil
+}
+
+// ValidateConfig validates the requested Configuration via the underlying ToolManager.
+func (s *Server) ValidateConfig(ctx context.Context, req *toolmanagerpb.ValidateConfigRequest) (*toolmanagerpb.ValidateConfigResponse, error) {
+	if err := s.toolManager.ValidateConfig(toConfiguration(req.Config)); err != nil {
+		return &toolmanagerpb.ValidateConfigResponse{Valid: false, Error: err.Error()}, nil
+	}
+	return &toolmanagerpb.ValidateConfigResponse{Valid: true}, nil
+}
+
+// GetSystemInfo is not backed by ToolManager; callers should wire a
+// services.SystemService into a future revision of this server if remote
+// system info is needed.
+func (s *Server) GetSystemInfo(ctx context.Context, req *toolmanagerpb.GetSystemInfoRequest) (*toolmanagerpb.GetSystemInfoResponse, error) {
+	return nil, fmt.Errorf("GetSystemInfo is not implemented by this server")
+}
+
+// StreamLogs streams an instance's captured log lines to the client.
+func (s *Server) StreamLogs(req *toolmanagerpb.StreamLogsRequest, stream toolmanagerpb.ToolManagerService_StreamLogsServer) error {
+	streamer, ok := s.toolManager.(interface {
+		StreamLogs(ctx context.Context, instanceID string, opts entities.LogStreamOptions) (<-chan entities.LogLine, error)
+	})
+	if !ok {
+		return fmt.Errorf("underlying ToolManager does not support StreamLogs")
+	}
+
+	ctx := stream.Context()
+	opts := entities.LogStreamOptions{
+		Follow: req.Follow,
+		Tail:   int(req.Tail),
+	}
+	if req.Since != nil {
+		opts.Since = *req.Since
+	}
+	if req.Until != nil {
+		opts.Until = *req.Until
+	}
+	lines, err := streamer.StreamLogs(ctx, req.InstanceId, opts)
+	if err != nil {
+		return fmt.Errorf("failed to stream logs: %w", err)
+	}
+
+	for line := range lines {
+		if err := stream.Send(&toolmanagerpb.LogLine{
+			Timestamp: line.Timestamp,
+			Stream:    string(line.Stream),
+			Line:      line.Line,
+		}); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// StreamStats reports an instance's status once a second until the client
+// disconnects or the instance stops being tracked.
+func (s *Server) StreamStats(req *toolmanagerpb.StreamStatsRequest, stream toolmanagerpb.ToolManagerService_StreamStatsServer) error {
+	ctx := stream.Context()
+	for {
+		status, err := s.toolManager.GetStatus(ctx, req.InstanceId)
+		if err != nil {
+			return fmt.Errorf("failed to get status: %w", err)
+		}
+		if err := stream.Send(&toolmanagerpb.InstanceStats{
+			InstanceId: req.InstanceId,
+			Status:     string(status),
+		}); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// StreamEvents is not implemented: Server holds no services.InstanceEventBus,
+// so it returns an error without streaming any lifecycle events.
+func (s *Server) StreamEvents(req *toolmanagerpb.StreamEventsRequest, stream toolmanagerpb.ToolManagerService_StreamEventsServer) error {
+	return fmt.Errorf("StreamEvents is not implemented: no lifecycle event bus is wired into this server")
+}